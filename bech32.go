@@ -0,0 +1,78 @@
+package validator
+
+import (
+	"errors"
+	"strings"
+)
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// Bech32 will validate that a string, val, is a BIP-173 bech32 string made up
+// of a human readable part, the separator 1 and a data part ending in a
+// 6 character checksum, such as a native segwit bitcoin address.
+// rules are:
+// length: <= 90
+// human readable part: 1 to 83 characters in the ascii range 33-126
+// data part: at least 6 characters from the bech32 charset
+// case: all upper or all lower, mixed case will fail
+// checksum: must be valid
+// It does not check the decoded data, such as a segwit version or program.
+func Bech32(val string) ValidationFunc {
+	return func() error {
+		if !validBech32(val) {
+			return errors.New(message(MessageBech32))
+		}
+		return nil
+	}
+}
+
+// validBech32 reports if val is a well formed bech32 string with a valid checksum.
+func validBech32(val string) bool {
+	if len(val) > 90 {
+		return false
+	}
+	lower := strings.ToLower(val)
+	if val != lower && val != strings.ToUpper(val) {
+		return false
+	}
+	sep := strings.LastIndexByte(lower, '1')
+	if sep < 1 || sep > 83 || len(lower)-sep-1 < 6 {
+		return false
+	}
+	hrp, data := lower[:sep], lower[sep+1:]
+	values := make([]byte, 0, len(hrp)*2+1+len(data))
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return false
+		}
+		values = append(values, hrp[i]>>5)
+	}
+	values = append(values, 0)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]&31)
+	}
+	for i := 0; i < len(data); i++ {
+		idx := strings.IndexByte(bech32Charset, data[i])
+		if idx < 0 {
+			return false
+		}
+		values = append(values, byte(idx))
+	}
+	return bech32Polymod(values) == 1
+}
+
+// bech32Polymod calculates the BCH checksum of values as defined in BIP-173.
+func bech32Polymod(values []byte) uint32 {
+	gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
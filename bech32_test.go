@@ -0,0 +1,71 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestBech32(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"upper case string should pass": {
+			val: "A12UEL5L",
+		},
+		"lower case string should pass": {
+			val: "a12uel5l",
+		},
+		"83 character human readable part should pass": {
+			val: "an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs",
+		},
+		"full charset should pass": {
+			val: "abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw",
+		},
+		"segwit address should pass": {
+			val: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		},
+		"corrupted checksum should fail": {
+			val:    "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5",
+			expErr: errors.New(validateBech32),
+		},
+		"mixed case should fail": {
+			val:    "a12UEL5L",
+			expErr: errors.New(validateBech32),
+		},
+		"missing separator should fail": {
+			val:    "pzry9x0s0muk",
+			expErr: errors.New(validateBech32),
+		},
+		"empty human readable part should fail": {
+			val:    "10a06t8",
+			expErr: errors.New(validateBech32),
+		},
+		"invalid data character should fail": {
+			val:    "x1b4n0q5v",
+			expErr: errors.New(validateBech32),
+		},
+		"short checksum should fail": {
+			val:    "li1dgmt3",
+			expErr: errors.New(validateBech32),
+		},
+		"overall length over 90 should fail": {
+			val:    "an84characterslonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1569pvx",
+			expErr: errors.New(validateBech32),
+		},
+		"empty string should fail": {
+			val:    "",
+			expErr: errors.New(validateBech32),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Bech32(test.val)())
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// cronField describes the name and allowed range of a single cron field
+// along with any names that can be used in place of numbers.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+// cronFields returns the 5 standard cron fields, when seconds is true
+// a leading seconds field is included.
+func cronFields(seconds bool) []cronField {
+	fields := make([]cronField, 0, 6)
+	if seconds {
+		fields = append(fields, cronField{name: "second", min: 0, max: 59})
+	}
+	return append(fields,
+		cronField{name: "minute", min: 0, max: 59},
+		cronField{name: "hour", min: 0, max: 23},
+		cronField{name: "day of month", min: 1, max: 31},
+		cronField{name: "month", min: 1, max: 12, names: []string{
+			"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC",
+		}},
+		cronField{name: "day of week", min: 0, max: 7, names: []string{
+			"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT",
+		}},
+	)
+}
+
+// CronExpression will check that a string, val, is a standard 5 field cron
+// expression of minute, hour, day of month, month and day of week.
+// Each field can be *, a value, a range such as 1-5, a step such as */15
+// or 1-30/5, or a comma separated list of these.
+// Months and days of the week can also be given as three letter names,
+// such as JAN or MON, and day of week accepts 0 or 7 for Sunday.
+// Expressions with any other number of fields will fail,
+// use CronExpressionWithSeconds to allow a leading seconds field.
+func CronExpression(val string) ValidationFunc {
+	return func() error {
+		return parseCron(val, cronFields(false))
+	}
+}
+
+// CronExpressionWithSeconds will check that a string, val, is a 6 field cron
+// expression, that is a seconds field of 0-59 followed by the 5 fields
+// checked by CronExpression.
+func CronExpressionWithSeconds(val string) ValidationFunc {
+	return func() error {
+		return parseCron(val, cronFields(true))
+	}
+}
+
+// parseCron checks val has one entry per field in fields and that
+// each entry is valid for its field.
+func parseCron(val string, fields []cronField) error {
+	parts := strings.Fields(val)
+	if len(parts) != len(fields) {
+		return fmt.Errorf(message(MessageCronFields), len(fields), len(parts))
+	}
+	for i, f := range fields {
+		if err := f.parse(parts[i]); err != nil {
+			return fmt.Errorf(message(MessageCronField), f.name, parts[i])
+		}
+	}
+	return nil
+}
+
+// parse checks a single field, val, which can be a comma separated list.
+func (c cronField) parse(val string) error {
+	for _, item := range strings.Split(val, ",") {
+		if err := c.parseItem(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseItem checks a single list item, being *, a value or range with an
+// optional step.
+func (c cronField) parseItem(item string) error {
+	rng, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n < 1 || n > c.max {
+			return errors.New("invalid step")
+		}
+	}
+	if rng == "*" {
+		return nil
+	}
+	lo, hi, isRange := strings.Cut(rng, "-")
+	if !isRange {
+		if hasStep {
+			return errors.New("step requires * or a range")
+		}
+		_, err := c.value(lo)
+		return err
+	}
+	from, err := c.value(lo)
+	if err != nil {
+		return err
+	}
+	to, err := c.value(hi)
+	if err != nil {
+		return err
+	}
+	if from > to {
+		return errors.New("range start is after end")
+	}
+	return nil
+}
+
+// value converts a number or name into an int, ensuring it is in range.
+func (c cronField) value(s string) (int, error) {
+	for i, n := range c.names {
+		if strings.EqualFold(s, n) {
+			return i + c.min, nil
+		}
+	}
+	if strings.TrimLeft(s, "0123456789") != "" {
+		return 0, errors.New("value is not a number")
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if n < c.min || n > c.max {
+		return 0, errors.New("value out of range")
+	}
+	return n, nil
+}
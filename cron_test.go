@@ -0,0 +1,114 @@
+package validator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestCronExpression(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"every minute should pass": {
+			val: "* * * * *",
+		},
+		"steps ranges and lists should pass": {
+			val: "*/15 0-6,18-23/2 1,15 * 1-5",
+		},
+		"names should pass": {
+			val: "30 9 * jan-MAR MON,fri",
+		},
+		"sunday as 7 should pass": {
+			val: "0 0 * * 7",
+		},
+		"extra whitespace should pass": {
+			val: " 0  12 *\t* * ",
+		},
+		"4 fields should fail": {
+			val:    "* * * *",
+			expErr: fmt.Errorf(validateCronFields, 5, 4),
+		},
+		"6 fields should fail": {
+			val:    "0 * * * * *",
+			expErr: fmt.Errorf(validateCronFields, 5, 6),
+		},
+		"empty expression should fail": {
+			val:    "",
+			expErr: fmt.Errorf(validateCronFields, 5, 0),
+		},
+		"minute out of range should fail": {
+			val:    "60 * * * *",
+			expErr: fmt.Errorf(validateCronField, "minute", "60"),
+		},
+		"hour out of range should fail": {
+			val:    "0 24 * * *",
+			expErr: fmt.Errorf(validateCronField, "hour", "24"),
+		},
+		"day of month zero should fail": {
+			val:    "0 0 0 * *",
+			expErr: fmt.Errorf(validateCronField, "day of month", "0"),
+		},
+		"unknown month name should fail": {
+			val:    "0 0 1 FOO *",
+			expErr: fmt.Errorf(validateCronField, "month", "FOO"),
+		},
+		"reversed range should fail": {
+			val:    "0 0 * * 5-1",
+			expErr: fmt.Errorf(validateCronField, "day of week", "5-1"),
+		},
+		"zero step should fail": {
+			val:    "*/0 * * * *",
+			expErr: fmt.Errorf(validateCronField, "minute", "*/0"),
+		},
+		"step without range should fail": {
+			val:    "5/10 * * * *",
+			expErr: fmt.Errorf(validateCronField, "minute", "5/10"),
+		},
+		"empty list item should fail": {
+			val:    "1,,2 * * * *",
+			expErr: fmt.Errorf(validateCronField, "minute", "1,,2"),
+		},
+		"signed value should fail": {
+			val:    "+5 * * * *",
+			expErr: fmt.Errorf(validateCronField, "minute", "+5"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, CronExpression(test.val)())
+		})
+	}
+}
+
+func TestCronExpressionWithSeconds(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"6 fields should pass": {
+			val: "*/30 0 12 * * MON-FRI",
+		},
+		"5 fields should fail": {
+			val:    "0 12 * * *",
+			expErr: fmt.Errorf(validateCronFields, 6, 5),
+		},
+		"second out of range should fail": {
+			val:    "60 0 12 * * *",
+			expErr: fmt.Errorf(validateCronField, "second", "60"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, CronExpressionWithSeconds(test.val)())
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CodeInvalid is the code recorded by ErrValidationDetailed when a failing
+// ValidationFunc has not been given a code using WithCode and its message is
+// not a built in message, such as custom functions or WithMessage.
+const CodeInvalid = "invalid"
+
+// FieldError is a single validation failure containing a machine readable
+// code as well as the human readable message.
+type FieldError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ErrValidationDetailed contains a list of field names and the FieldErrors
+// found against each. It is an alternative to ErrValidation for clients
+// that need to react to specific failures rather than just display them.
+type ErrValidationDetailed map[string][]FieldError
+
+// NewDetailed will create and return a new ErrValidationDetailed which can
+// have Validate functions chained.
+//
+//	err := validator.NewDetailed().
+//	    Validate("name", validator.WithCode(validator.StrLength(name, 4, 10), "too_short")).
+//	    Validate("email", validator.WithCode(validator.Email(email), "not_email")).
+//	    Err()
+func NewDetailed() ErrValidationDetailed {
+	return map[string][]FieldError{}
+}
+
+// Validate will log any errors found when evaluating the list of validation functions
+// supplied to it. The code is taken from WithCode if set, otherwise the code of
+// the built in message matching the error is used, falling back to CodeInvalid.
+func (e ErrValidationDetailed) Validate(field string, fns ...ValidationFunc) ErrValidationDetailed {
+	out := make([]FieldError, 0, len(fns))
+	for _, fn := range fns {
+		if err := fn(); err != nil {
+			out = append(out, FieldError{Code: errorCode(err), Message: err.Error()})
+		}
+	}
+	if len(out) > 0 {
+		e[field] = out
+	}
+	return e
+}
+
+// Err will return nil if no errors are found, ie all validators return valid
+// or ErrValidationDetailed if an error has been found.
+func (e ErrValidationDetailed) Err() error {
+	if len(e) > 0 {
+		return e
+	}
+	return nil
+}
+
+// String implements the Stringer interface and
+// will return a string based representation
+// of any errors found.
+func (e ErrValidationDetailed) String() string {
+	if len(e) == 0 {
+		return "no validation errors"
+	}
+	errs := make([]string, 0, len(e))
+	for k, vv := range e {
+		msgs := make([]string, 0, len(vv))
+		for _, v := range vv {
+			msgs = append(msgs, fmt.Sprintf("%s (%s)", v.Message, v.Code))
+		}
+		errs = append(errs, fmt.Sprintf("[%s: %s]", k, strings.Join(msgs, ", ")))
+	}
+	sort.Strings(errs)
+	return strings.Join(errs, ", ")
+}
+
+// Error implements the Error interface and ensure that ErrValidationDetailed
+// can be passed as an error as well and being printable.
+func (e ErrValidationDetailed) Error() string {
+	return e.String()
+}
+
+// BadRequest implements the err BadRequest behaviour
+// from the https://github.com/theflyingcodr/lathos package.
+func (e ErrValidationDetailed) BadRequest() bool {
+	return true
+}
+
+// WithCode will attach a machine readable code to any error returned by fn,
+// overriding the default code, the message is unchanged. The code is recorded
+// by ErrValidationDetailed and ignored by ErrValidation.
+func WithCode(fn ValidationFunc, code string) ValidationFunc {
+	return func() error {
+		if err := fn(); err != nil {
+			return codeError{code: code, err: err}
+		}
+		return nil
+	}
+}
+
+// codeError is an error with a code attached by WithCode.
+type codeError struct {
+	code string
+	err  error
+}
+
+func (c codeError) Error() string {
+	return c.err.Error()
+}
+
+func (c codeError) Unwrap() error {
+	return c.err
+}
+
+// errorCode returns the code attached to err by WithCode, the code of the
+// current message err matches or CodeInvalid. Where more than one message
+// matches, the one with the most fixed text wins.
+func errorCode(err error) string {
+	var c codeError
+	if errors.As(err, &c) {
+		return c.code
+	}
+	codes := messageCodes()
+	code, best := CodeInvalid, -1
+	msg := err.Error()
+	messagesMu.RLock()
+	defer messagesMu.RUnlock()
+	for k, format := range messages {
+		n, ok := matchFormat(format, msg)
+		if !ok || codes[k] == "" {
+			continue
+		}
+		if n > best || (n == best && codes[k] < code) {
+			code, best = codes[k], n
+		}
+	}
+	return code
+}
+
+// matchFormat reports whether s could have been produced by the fmt style
+// format, treating each verb as matching any text. It also returns the
+// number of fixed characters in format so that matches can be ranked.
+func matchFormat(format, s string) (int, bool) {
+	parts := []string{""}
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			parts[len(parts)-1] += string(format[i])
+			continue
+		}
+		i++
+		if i < len(format) && format[i] == '%' {
+			parts[len(parts)-1] += "%"
+			continue
+		}
+		for i < len(format) && strings.IndexByte("+-# 0123456789.*[]", format[i]) >= 0 {
+			i++
+		}
+		parts = append(parts, "")
+	}
+	n := 0
+	for _, p := range parts {
+		n += len(p)
+	}
+	if len(parts) == 1 {
+		return n, s == parts[0]
+	}
+	first, last := parts[0], parts[len(parts)-1]
+	if len(s) < len(first)+len(last) || !strings.HasPrefix(s, first) || !strings.HasSuffix(s, last) {
+		return n, false
+	}
+	s = s[len(first) : len(s)-len(last)]
+	for _, p := range parts[1 : len(parts)-1] {
+		idx := strings.Index(s, p)
+		if idx < 0 {
+			return n, false
+		}
+		s = s[idx+len(p):]
+	}
+	return n, true
+}
+
+// messageCodes returns the machine readable code recorded by
+// ErrValidationDetailed for each of the built in messages.
+func messageCodes() map[MessageKey]string {
+	return map[MessageKey]string{
+		MessageNotEmpty:           "required",
+		MessageEmpty:              "must_be_empty",
+		MessageLength:             "invalid_length",
+		MessageExactLength:        "invalid_length",
+		MessageSliceLength:        "invalid_item_count",
+		MessageSliceExactLength:   "invalid_item_count",
+		MessageMinItems:           "too_few_items",
+		MessageMaxItems:           "too_many_items",
+		MessageSorted:             "not_sorted",
+		MessageSliceContains:      "missing_item",
+		MessageMapHasKey:          "missing_key",
+		MessageMin:                "too_small",
+		MessageMax:                "too_large",
+		MessageNumBetween:         "out_of_range",
+		MessagePositive:           "not_positive",
+		MessagePrecision:          "too_precise",
+		MessagePercentOf:          "exceeds_percent",
+		MessageOrderMin:           "below_minimum_order",
+		MessageOrderPack:          "not_pack_multiple",
+		MessageRegex:              "invalid_format",
+		MessageBool:               "not_equal",
+		MessageDateEqual:          "date_mismatch",
+		MessageDateAfter:          "too_early",
+		MessageDateBefore:         "too_late",
+		MessageDateAfterEq:        "too_early",
+		MessageDateBeforeEq:       "too_late",
+		MessageDateWithin:         "outside_window",
+		MessageWeekday:            "not_weekday",
+		MessageWeekend:            "not_weekend",
+		MessageWeekdayIn:          "day_not_allowed",
+		MessageDateString:         "invalid_date",
+		MessageSameDay:            "different_days",
+		MessageBackoffInitial:     "invalid_initial_interval",
+		MessageBackoffMax:         "invalid_max_interval",
+		MessageBackoffOrder:       "initial_exceeds_max",
+		MessageBackoffMultiplier:  "invalid_multiplier",
+		MessageUKPostCode:         "invalid_postcode",
+		MessageZipCode:            "invalid_postcode",
+		MessageHasPrefix:          "invalid_prefix",
+		MessageNoPrefix:           "invalid_prefix",
+		MessageHex:                "not_hex",
+		MessageAny:                "not_allowed",
+		MessageIsNumeric:          "not_numeric",
+		MessageEmail:              "not_email",
+		MessageAllWordsIn:         "word_not_allowed",
+		MessagePlaceholder:        "unresolved_placeholder",
+		MessageClosedRing:         "invalid_ring",
+		MessageCSPNonce:           "invalid_nonce",
+		MessageEnvVarName:         "invalid_env_var_name",
+		MessageExtension:          "invalid_extension",
+		MessageMACOUI:             "invalid_mac_oui",
+		MessageFlagKey:            "invalid_flag_key",
+		MessageNoNewline:          "contains_newline",
+		MessageNoBidi:             "contains_bidi",
+		MessageNot:                "negation_failed",
+		MessageOr:                 "no_condition_met",
+		MessageBlank:              "must_be_blank",
+		MessageContainsDigit:      "missing_digit",
+		MessageContainsUpper:      "missing_upper",
+		MessageContainsLower:      "missing_lower",
+		MessageMatchAny:           "no_pattern_matched",
+		MessageNotMatch:           "disallowed_pattern",
+		MessageMinLength:          "too_short",
+		MessageMaxLength:          "too_long",
+		MessageNotStruct:          "not_struct",
+		MessageUnknownRule:        "unknown_rule",
+		MessageUnsupportedRule:    "unsupported_rule",
+		MessageRuleParam:          "invalid_rule_param",
+		MessageDateNotBetween:     "date_in_blackout",
+		MessageConfirm:            "confirmation_mismatch",
+		MessageNonNegative:        "negative",
+		MessageNonPositive:        "positive",
+		MessageASCII:              "not_ascii",
+		MessagePrintableASCII:     "not_printable_ascii",
+		MessageNoControlChars:     "contains_control_chars",
+		MessageRequiredKeys:       "missing_keys",
+		MessageCAPostalCode:       "invalid_postcode",
+		MessagePostalCode:         "invalid_postcode",
+		MessagePostalCodeCountry:  "unsupported_country",
+		MessageDataURI:            "invalid_data_uri",
+		MessageMIMEType:           "invalid_mime_type",
+		MessageMIMETypeIn:         "mime_type_not_allowed",
+		MessageCronFields:         "invalid_cron",
+		MessageCronField:          "invalid_cron",
+		MessageEthereumAddress:    "invalid_ethereum_address",
+		MessageEthereumChecksum:   "invalid_checksum",
+		MessageBech32:             "invalid_bech32",
+		MessageTimezone:           "invalid_timezone",
+		MessageLanguageTag:        "invalid_language_tag",
+		MessageNumericOverflow:    "out_of_range",
+		MessageIsFloat:            "not_float",
+		MessageIsBool:             "not_bool",
+		MessageParseUint:          "not_uint",
+		MessageUintOverflow:       "out_of_range",
+		MessageCardExpiryMonth:    "invalid_expiry_month",
+		MessageCardExpired:        "card_expired",
+		MessageUsernameChars:      "invalid_characters",
+		MessageUsernameDigit:      "leading_digit",
+		MessageDomain:             "invalid_domain",
+		MessageEmailDomain:        "domain_not_allowed",
+		MessageEmailDomainBlocked: "domain_blocked",
+		MessageVATNumber:          "invalid_vat_number",
+		MessageVATCountry:         "unsupported_country",
+		MessageSSN:                "invalid_ssn",
+		MessageAtLeastOne:         "required",
+		MessageNumericLength:      "invalid_length",
+		MessageIMEI:               "invalid_imei",
+		MessageLatLng:             "invalid_lat_lng",
+		MessageTrimmed:            "untrimmed",
+		MessageNotInWordlist:      "disallowed_word",
+		MessageContainsAll:        "missing_items",
+		MessageSubset:             "item_not_allowed",
+		MessageCountMatching:      "invalid_match_count",
+		MessageIn:                 "not_allowed",
+		MessagePattern:            "invalid_pattern",
+		MessageByteLength:         "invalid_length",
+		MessageLuhn:               "invalid_check_digit",
+	}
+}
@@ -0,0 +1,145 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func Test_DetailedValidate(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tests := map[string]struct {
+		fns []ValidationFunc
+		exp ErrValidationDetailed
+	}{
+		"passing validators should record nothing": {
+			fns: []ValidationFunc{WithCode(Email("test@test.com"), "not_email")},
+			exp: ErrValidationDetailed{},
+		}, "coded validators should record code and message": {
+			fns: []ValidationFunc{
+				WithCode(StrLength("ab", 4, 10), "too_short"),
+				WithCode(Email("ab"), "not_email"),
+			},
+			exp: ErrValidationDetailed{
+				"test": {
+					{Code: "too_short", Message: fmt.Sprintf(validateLength, 4, 10)},
+					{Code: "not_email", Message: validateEmail},
+				},
+			},
+		}, "uncoded validators should record the default code": {
+			fns: []ValidationFunc{PositiveNumber(-1), Equal(1, 2), USZipCode("abc")},
+			exp: ErrValidationDetailed{
+				"test": {
+					{Code: "not_positive", Message: fmt.Sprintf(validatePositive, -1)},
+					{Code: "not_equal", Message: fmt.Sprintf(validateBool, 1, 2)},
+					{Code: "invalid_postcode", Message: fmt.Sprintf(validateZipCode, "abc")},
+				},
+			},
+		}, "custom messages should record the invalid code": {
+			fns: []ValidationFunc{WithMessage(PositiveNumber(-1), "bad"), func() error {
+				return errors.New("oh no")
+			}},
+			exp: ErrValidationDetailed{
+				"test": {
+					{Code: CodeInvalid, Message: "bad"},
+					{Code: CodeInvalid, Message: "oh no"},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.exp, NewDetailed().Validate("test", test.fns...))
+		})
+	}
+}
+
+func Test_DetailedErr(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	is.NoErr(NewDetailed().Validate("name", Equal(1, 1)).Err())
+	err := NewDetailed().
+		Validate("name", WithCode(StrLength("ab", 4, 10), "too_short")).
+		Validate("email", WithCode(Email("ab"), "not_email")).
+		Err()
+	var e ErrValidationDetailed
+	is.True(errors.As(err, &e))
+	is.Equal("[email: invalid email (not_email)], [name: value must be between 4 and 10 characters (too_short)]",
+		err.Error())
+}
+
+func TestWithCode(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	is.NoErr(WithCode(Equal(1, 1), "not_equal")())
+	err := WithCode(Equal(1, 2), "not_equal")()
+	is.Equal(fmt.Sprintf(validateBool, 1, 2), err.Error())
+	is.Equal("not_equal", errorCode(err))
+	is.Equal("not_equal", errorCode(Equal(1, 2)()))
+	is.Equal("mandatory", errorCode(WithCode(NotEmpty(""), "mandatory")()))
+	is.Equal(fmt.Errorf(validateBool, 1, 2), errors.Unwrap(err))
+	// the string based API should only record the message
+	is.Equal(ErrValidation{"test": {fmt.Sprintf(validateBool, 1, 2)}},
+		New().Validate("test", WithCode(Equal(1, 2), "not_equal")))
+}
+
+func TestMessageCodes(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	codes := messageCodes()
+	byMessage := map[string]string{}
+	for k, v := range defaultMessages() {
+		is.True(codes[k] != "")
+		// identical messages cannot be told apart so must share a code
+		if c, ok := byMessage[v]; ok {
+			is.Equal(c, codes[k])
+		}
+		byMessage[v] = codes[k]
+	}
+}
+
+func Test_matchFormat(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tests := map[string]struct {
+		format string
+		s      string
+		n      int
+		exp    bool
+	}{
+		"plain message should match exactly": {
+			format: validateEmail,
+			s:      "invalid email",
+			n:      13,
+			exp:    true,
+		}, "verbs should match any text": {
+			format: validateNumBetween,
+			s:      "value 10 must be between 1 and 5",
+			n:      28,
+			exp:    true,
+		}, "escaped percent should match literally": {
+			format: validatePercentOf,
+			s:      "value 60 exceeds 50% of 100",
+			n:      20,
+			exp:    true,
+		}, "different text should not match": {
+			format: validateNumBetween,
+			s:      "value 10 must be less than 5",
+			n:      28,
+			exp:    false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			n, ok := matchFormat(test.format, test.s)
+			is.Equal(test.exp, ok)
+			is.Equal(test.n, n)
+		})
+	}
+}
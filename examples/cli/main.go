@@ -16,11 +16,13 @@ func main() {
 	flag.StringVar(&name, "name", "", "name of a thing")
 	flag.IntVar(&total, "total", 0, "an amount of something or other, who knows")
 	flag.Parse()
-	if err := validator.New().
+	errs := validator.New().
 		Validate("name", validator.StrLength(name, 1, 20)).
-		Validate("amount", validator.MinNumber(total, 10)).Err(); err != nil {
-		fmt.Println(err)
+		Validate("amount", validator.MinNumber(total, 10))
+	if errs.Err() != nil {
+		fmt.Println(errs.PrettyString())
 		flag.PrintDefaults()
+		return
 	}
 	fmt.Println("all valid, nice")
 }
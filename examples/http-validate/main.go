@@ -29,6 +29,9 @@ type Request struct {
 	Count     int       `json:"count"`
 }
 
+// ensure Request satisfies the validator.Validator interface checked in parseRequest.
+var _ validator.Validator = (*Request)(nil)
+
 // Validate implements validator.Validator and evaluates Request.
 func (r *Request) Validate() validator.ErrValidation {
 	return validator.New().
@@ -85,11 +88,8 @@ func errorHandler(h httpHandlerFunc) http.HandlerFunc {
 			return
 		}
 		if e, ok := err.(validator.ErrValidation); ok {
-			resp := map[string]interface{}{
-				"errors": e,
-			}
 			w.WriteHeader(http.StatusBadRequest)
-			_ = json.NewEncoder(w).Encode(&resp)
+			_ = json.NewEncoder(w).Encode(e.Response())
 			return
 		}
 		w.WriteHeader(http.StatusInternalServerError)
@@ -1,61 +1,300 @@
 package validator
 
 import (
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
+	"mime"
 	"net/mail"
+	"net/url"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"golang.org/x/crypto/sha3"
 	"golang.org/x/exp/constraints"
 )
 
 var (
-	reUKPostCode = regexp.MustCompile(`^[a-zA-Z]{1,2}\d[a-zA-Z\d]?\s*\d[a-zA-Z]{2}$`)
-	reZipCode    = regexp.MustCompile(`^(\d{5}(?:\-\d{4})?)$`)
+	reUKPostCode  = regexp.MustCompile(`^[a-zA-Z]{1,2}\d[a-zA-Z\d]?\s*\d[a-zA-Z]{2}$`)
+	reZipCode     = regexp.MustCompile(`^(\d{5}(?:\-\d{4})?)$`)
+	reCAPostCode  = regexp.MustCompile(`(?i)^[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d$`)
+	rePlaceholder = regexp.MustCompile(`{{\s*([^{}\s]+)\s*}}`)
+	reEnvVarName  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	reExtension   = regexp.MustCompile(`^(?:[1-9]\d{2,5}|0{3,6})$`)
+	reMACOUI      = regexp.MustCompile(`^[0-9A-F]{2}:[0-9A-F]{2}:[0-9A-F]{2}$`)
+	reFlagSegment = regexp.MustCompile(`^[a-z0-9_]+$`)
+	reEthAddress  = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+	reUsername    = regexp.MustCompile(`^[a-zA-Z0-9_-]*$`)
+	reDomain      = regexp.MustCompile(`(?i)^(?:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+	reSSN         = regexp.MustCompile(`^(?:\d{3}-\d{2}-\d{4}|\d{9})$`)
+	reLangTag     = regexp.MustCompile(`(?i)^(?:[a-z]{2,3}(?:-[a-z]{3}){0,3}(?:-[a-z]{4})?(?:-(?:[a-z]{2}|\d{3}))?` +
+		`(?:-(?:[a-z\d]{5,8}|\d[a-z\d]{3}))*(?:-[\da-wyz](?:-[a-z\d]{2,8})+)*(?:-x(?:-[a-z\d]{1,8})+)?|x(?:-[a-z\d]{1,8})+)$`)
 )
 
 const (
-	validateEmpty       = "value cannot be empty"
-	validateNotEmpty    = "value must be empty"
-	validateLength      = "value must be between %d and %d characters"
-	validateExactLength = "value should be exactly %d characters"
-	validateMin         = "value %v is smaller than minimum %v"
-	validateMax         = "value %v is larger than maximum %v"
-	validateNumBetween  = "value %v must be between %v and %v"
-	validatePositive    = "value %v should be greater than 0"
-	validateRegex       = "value %s failed to meet requirements"
-	validateBool        = "value %v does not evaluate to %v"
-	validateDateEqual   = "the date/time provided %s, does not match the expected %s"
-	validateDateAfter   = "the date provided %s, must be after %s"
-	validateDateBefore  = "the date provided %s, must be before %s"
-	validateUkPostCode  = "%s is not a valid UK PostCode"
-	validateIsNumeric   = "string %s is not a number"
-	validateEmail       = "invalid email"
+	validateEmpty              = "value cannot be empty"
+	validateNotEmpty           = "value must be empty"
+	validateAtLeastOne         = "at least one of %d values must be provided"
+	validateBlank              = "value must be blank"
+	validateTrimmed            = "value cannot have leading or trailing whitespace"
+	validateLength             = "value must be between %d and %d characters"
+	validateByteLength         = "value must be between %d and %d bytes"
+	validateExactLength        = "value should be exactly %d characters"
+	validateMinLength          = "value must be at least %d characters"
+	validateMaxLength          = "value must be at most %d characters"
+	validateSliceLength        = "value must have between %d and %d items"
+	validateSliceExactLength   = "value should have exactly %d items"
+	validateMinItems           = "value must have at least %d items"
+	validateMaxItems           = "value must have at most %d items"
+	validateSorted             = "value is not in %s order, index %d is out of order"
+	validateSliceContains      = "value %v is required but was not found"
+	validateContainsAll        = "values %v are required but were not found"
+	validateSubset             = "value %v is not allowed"
+	validateCountMatching      = "%d items matched but between %d and %d are required"
+	validateMapHasKey          = "key %v is required but was not found"
+	validateRequiredKeys       = "missing required keys: %s"
+	validateMin                = "value %v is smaller than minimum %v"
+	validateMax                = "value %v is larger than maximum %v"
+	validateNumBetween         = "value %v must be between %v and %v"
+	validatePositive           = "value %v should be greater than 0"
+	validateNonNegative        = "value %v must be zero or greater"
+	validateNonPositive        = "value %v must be zero or less"
+	validatePrecision          = "value %v has more than %d decimal places of precision"
+	validatePercentOf          = "value %v exceeds %v%% of %v"
+	validateOrderMin           = "quantity %v is below the minimum order of %v"
+	validateOrderPack          = "quantity %v is not a multiple of the pack size %v"
+	validateRegex              = "value %s failed to meet requirements"
+	validateMatchAny           = "value %s did not match any of the required patterns"
+	validatePattern            = "pattern %q is not a valid regular expression: %s"
+	validateNotMatch           = "value %s contains a disallowed pattern"
+	validateBool               = "value %v does not evaluate to %v"
+	validateConfirm            = "confirmation does not match"
+	validateDateEqual          = "the date/time provided %s, does not match the expected %s"
+	validateDateAfter          = "the date provided %s, must be after %s"
+	validateDateBefore         = "the date provided %s, must be before %s"
+	validateDateAfterEq        = "the date provided %s, must be on or after %s"
+	validateDateBeforeEq       = "the date provided %s, must be on or before %s"
+	validateDateNotBetween     = "the date provided %s, must not be between %s and %s"
+	validateDateWithin         = "the date provided %s, must be within %s of %s"
+	validateWeekday            = "the date provided %s, must be a weekday"
+	validateWeekend            = "the date provided %s, must be a weekend"
+	validateWeekdayIn          = "the date provided %s, must fall on one of %v"
+	validateDateString         = "value %s does not match the date format %s"
+	validateSameDay            = "all timestamps must be on the same day, index %d differs"
+	validateBackoffInitial     = "initial interval must be greater than 0"
+	validateBackoffMax         = "max interval must be greater than 0"
+	validateBackoffOrder       = "initial interval %s cannot be greater than max interval %s"
+	validateBackoffMultiplier  = "multiplier %v must be greater than 1"
+	validateUkPostCode         = "%s is not a valid UK PostCode"
+	validateZipCode            = "%s is not a valid UK PostCode"
+	validateCAPostalCode       = "%s is not a valid Canadian postal code"
+	validateSSN                = "value is not a valid social security number"
+	validateIMEI               = "value is not a valid IMEI"
+	validateLuhn               = "value does not have a valid luhn check digit"
+	validatePostalCode         = "%s is not a valid postal code for %s"
+	validatePostalCodeCountry  = "postal code validation is unsupported for country %s"
+	validateVATNumber          = "%s is not a valid VAT number for %s"
+	validateVATCountry         = "VAT number validation is unsupported for country %s"
+	validateHasPrefix          = "value provided does not have a valid prefix"
+	validateNoPrefix           = "value provided does not have a valid prefix"
+	validateHex                = "value supplied is not valid hex"
+	validateAny                = "value not found in allowed values"
+	validateIn                 = "value %v must be one of %v"
+	validateIsNumeric          = "string %s is not a number"
+	validateNumericOverflow    = "string %s is out of range for an int"
+	validateNumericLength      = "value must be exactly %d digits"
+	validateIsFloat            = "string %s is not a valid float"
+	validateIsBool             = "string %s is not a valid boolean"
+	validateCardExpiryMonth    = "expiry month %d must be between 1 and 12"
+	validateCardExpired        = "card expired at the end of %02d/%d"
+	validateUsernameChars      = "value can only contain letters, numbers, underscores and hyphens"
+	validateUsernameDigit      = "value cannot start with a digit"
+	validateParseUint          = "string %s is not an unsigned int"
+	validateUintOverflow       = "string %s is out of range for a %d bit unsigned int"
+	validateEmail              = "invalid email"
+	validateEmailDomain        = "email domain %s is not allowed"
+	validateEmailDomainBlocked = "email domain %s is blocked"
+	validateDomain             = "%s is not a valid domain"
+	validateDataURI            = "value is not a valid data uri"
+	validateMIMEType           = "%s is not a valid MIME type"
+	validateMIMETypeIn         = "MIME type %s must be one of %s"
+	validateCronFields         = "cron expression must have %d fields but has %d"
+	validateCronField          = "cron %s field %q is invalid"
+	validateAllWordsIn         = "word %q is not in the allowed vocabulary"
+	validateNotInWordlist      = "value contains disallowed word %q"
+	validatePlaceholder        = "unresolved placeholders: %v"
+	validateClosedRing         = "polygon ring is not closed or valid"
+	validateLatLng             = "%s is not a valid latitude,longitude pair"
+	validateCSPNonce           = "value is not a valid CSP nonce"
+	validateEnvVarName         = "value %s is not a valid environment variable name"
+	validateExtension          = "value %s is not a valid extension"
+	validateMACOUI             = "value %s is not a valid MAC OUI"
+	validateEthereumAddress    = "value %s is not a valid ethereum address"
+	validateEthereumChecksum   = "ethereum address %s has an invalid checksum"
+	validateBech32             = "value is not a valid bech32 string"
+	validateTimezone           = "%s is not a valid timezone"
+	validateLanguageTag        = "%s is not a valid language tag"
+	validateFlagKey            = "value %s is not a valid flag key"
+	validateNoNewline          = "value cannot contain newlines"
+	validateNoBidi             = "value contains disallowed bidirectional control characters"
+	validateNot                = "value did not meet the negated condition"
+	validateOr                 = "value did not meet any condition: %s"
+	validateContainsDigit      = "value must contain at least one digit"
+	validateContainsUpper      = "value must contain at least one upper case letter"
+	validateContainsLower      = "value must contain at least one lower case letter"
+	validateASCII              = "value must only contain ascii characters"
+	validatePrintableASCII     = "value must only contain printable ascii characters"
+	validateNoControlChars     = "value must not contain control characters"
+	validateNotStruct          = "value of type %v is not a struct"
+	validateUnknownRule        = "unknown validation rule %q"
+	validateUnsupportedRule    = "validation rule %q is not supported for type %s"
+	validateRuleParam          = "validation rule %q has an invalid parameter %q"
 )
 
 // StrLength will ensure a string, val, has a length that is at least min and
-// at most max.
+// at most max. Length is measured in bytes, use StrLengthRunes to count characters.
 func StrLength(val string, min, max int) ValidationFunc {
 	return func() error {
 		if len(val) >= min && len(val) <= max {
 			return nil
 		}
-		return fmt.Errorf(validateLength, min, max)
+		return fmt.Errorf(message(MessageLength), min, max)
+	}
+}
+
+// Username will ensure a string, val, is a sensible username.
+// rules are:
+// length: between min and max characters inclusive
+// characters: ascii letters, digits, underscores and hyphens only
+// first character: not a digit
+// All failing rules are returned in a single error.
+func Username(val string, min, max int) ValidationFunc {
+	return func() error {
+		errs := make([]string, 0)
+		if err := StrLength(val, min, max)(); err != nil {
+			errs = append(errs, err.Error())
+		}
+		if !reUsername.MatchString(val) {
+			errs = append(errs, message(MessageUsernameChars))
+		}
+		if val != "" && val[0] >= '0' && val[0] <= '9' {
+			errs = append(errs, message(MessageUsernameDigit))
+		}
+		if len(errs) == 0 {
+			return nil
+		}
+		return errors.New(strings.Join(errs, ", "))
 	}
 }
 
-// StrLengthExact will ensure a string, val, is exactly length.
+// StrLengthExact will ensure a string, val, is exactly length bytes.
 func StrLengthExact(val string, length int) ValidationFunc {
 	return func() error {
 		if len(val) == length {
 			return nil
 		}
-		return fmt.Errorf(validateExactLength, length)
+		return fmt.Errorf(message(MessageExactLength), length)
+	}
+}
+
+// ByteLength will ensure a string, val, is at least min and at most max bytes
+// long. This suits storage limits such as fixed size database columns, where
+// a multibyte character such as "é" uses more than one byte. The error is
+// reported in bytes, use StrLengthRunes to limit the number of characters.
+func ByteLength(val string, min, max int) ValidationFunc {
+	return func() error {
+		if len(val) >= min && len(val) <= max {
+			return nil
+		}
+		return fmt.Errorf(message(MessageByteLength), min, max)
+	}
+}
+
+// StrLengthRunes will ensure a string, val, has a length that is at least min and
+// at most max. Length is measured in runes so multibyte characters such as "é"
+// are counted once.
+func StrLengthRunes(val string, min, max int) ValidationFunc {
+	return func() error {
+		l := utf8.RuneCountInString(val)
+		if l >= min && l <= max {
+			return nil
+		}
+		return fmt.Errorf(message(MessageLength), min, max)
+	}
+}
+
+// StrLengthExactRunes will ensure a string, val, is exactly length runes.
+func StrLengthExactRunes(val string, length int) ValidationFunc {
+	return func() error {
+		if utf8.RuneCountInString(val) == length {
+			return nil
+		}
+		return fmt.Errorf(message(MessageExactLength), length)
+	}
+}
+
+// SliceLength will ensure a slice, val, has a length that is at least min and
+// at most max.
+func SliceLength[T any](val []T, min, max int) ValidationFunc {
+	return func() error {
+		if len(val) >= min && len(val) <= max {
+			return nil
+		}
+		return fmt.Errorf(message(MessageSliceLength), min, max)
+	}
+}
+
+// SliceLengthExact will ensure a slice, val, is exactly length.
+func SliceLengthExact[T any](val []T, length int) ValidationFunc {
+	return func() error {
+		if len(val) == length {
+			return nil
+		}
+		return fmt.Errorf(message(MessageSliceExactLength), length)
+	}
+}
+
+// MinItems will ensure a slice, val, has at least min items. A nil slice has 0 items.
+func MinItems[T any](val []T, min int) ValidationFunc {
+	return func() error {
+		if len(val) >= min {
+			return nil
+		}
+		return fmt.Errorf(message(MessageMinItems), min)
+	}
+}
+
+// MaxItems will ensure a slice, val, has at most max items. A nil slice has 0 items.
+func MaxItems[T any](val []T, max int) ValidationFunc {
+	return func() error {
+		if len(val) <= max {
+			return nil
+		}
+		return fmt.Errorf(message(MessageMaxItems), max)
+	}
+}
+
+// Sorted will ensure a slice, val, is in ascending or descending order.
+// Equal neighbouring values are allowed, an empty or single item slice will pass.
+func Sorted[T constraints.Ordered](val []T, ascending bool) ValidationFunc {
+	return func() error {
+		for i := 1; i < len(val); i++ {
+			if ascending && val[i] < val[i-1] {
+				return fmt.Errorf(message(MessageSorted), "ascending", i)
+			}
+			if !ascending && val[i] > val[i-1] {
+				return fmt.Errorf(message(MessageSorted), "descending", i)
+			}
+		}
+		return nil
 	}
 }
 
@@ -70,7 +309,7 @@ func MinNumber[T Number](val, min T) ValidationFunc {
 		if val >= min {
 			return nil
 		}
-		return fmt.Errorf(validateMin, val, min)
+		return fmt.Errorf(message(MessageMin), val, min)
 	}
 }
 
@@ -80,7 +319,7 @@ func MaxNumber[T Number](val, max T) ValidationFunc {
 		if val <= max {
 			return nil
 		}
-		return fmt.Errorf(validateMax, val, max)
+		return fmt.Errorf(message(MessageMax), val, max)
 	}
 }
 
@@ -90,7 +329,7 @@ func BetweenNumber[T Number](val, min, max T) ValidationFunc {
 		if val >= min && val <= max {
 			return nil
 		}
-		return fmt.Errorf(validateNumBetween, val, min, max)
+		return fmt.Errorf(message(MessageNumBetween), val, min, max)
 	}
 }
 
@@ -100,7 +339,89 @@ func PositiveNumber[T Number](val T) ValidationFunc {
 		if val > 0 {
 			return nil
 		}
-		return fmt.Errorf(validatePositive, val)
+		return fmt.Errorf(message(MessagePositive), val)
+	}
+}
+
+// NonNegative will ensure a Number, val, is >= 0.
+func NonNegative[T Number](val T) ValidationFunc {
+	return func() error {
+		if val >= 0 {
+			return nil
+		}
+		return fmt.Errorf(message(MessageNonNegative), val)
+	}
+}
+
+// NonPositive will ensure a Number, val, is <= 0.
+func NonPositive[T Number](val T) ValidationFunc {
+	return func() error {
+		if val <= 0 {
+			return nil
+		}
+		return fmt.Errorf(message(MessageNonPositive), val)
+	}
+}
+
+// NumberIn will ensure a Number, val, is one of the allowed values.
+// An empty allowed list will always fail. It is a Number constrained form of In.
+func NumberIn[T Number](val T, allowed ...T) ValidationFunc {
+	return In(val, allowed...)
+}
+
+// AtMostPercentOf will ensure a float, val, is no more than percent of base.
+// For example a discount can be capped at 50 percent of the price.
+func AtMostPercentOf[T constraints.Float](val, base, percent T) ValidationFunc {
+	return func() error {
+		if val <= base*percent/100 {
+			return nil
+		}
+		return fmt.Errorf(message(MessagePercentOf), val, percent, base)
+	}
+}
+
+// OrderQuantity will ensure an integer quantity, qty, is at least minOrder and
+// is a multiple of packSize. A packSize of 0 or less disables the pack check.
+// If both rules fail, both reasons are returned in a single error.
+func OrderQuantity[T constraints.Integer](qty, minOrder, packSize T) ValidationFunc {
+	return func() error {
+		errs := make([]string, 0)
+		if qty < minOrder {
+			errs = append(errs, fmt.Sprintf(message(MessageOrderMin), qty, minOrder))
+		}
+		if packSize > 0 && qty%packSize != 0 {
+			errs = append(errs, fmt.Sprintf(message(MessageOrderPack), qty, packSize))
+		}
+		if len(errs) == 0 {
+			return nil
+		}
+		return errors.New(strings.Join(errs, ", "))
+	}
+}
+
+// precisionULPs is the tolerance allowed when comparing a scaled float against
+// its rounded value, in units of the float's own precision. This absorbs binary
+// representation error such as 0.1+0.2 or float32(19.99).
+const precisionULPs = 4
+
+// NoExcessPrecision will ensure a float, val, has no more than scale decimal places.
+// This is useful for currency values where sub unit precision is not supported.
+// NaN and infinity will fail.
+func NoExcessPrecision[T constraints.Float](val T, scale int) ValidationFunc {
+	return func() error {
+		f := float64(val)
+		if !math.IsNaN(f) && !math.IsInf(f, 0) {
+			epsilon := math.Ldexp(1, -52)
+			if reflect.TypeOf(val).Bits() == 32 {
+				epsilon = math.Ldexp(1, -23)
+			}
+			// values too large to scale have no fractional part.
+			scaled := f * math.Pow10(scale)
+			if math.IsInf(scaled, 0) || math.Abs(scaled-math.Round(scaled)) <= precisionULPs*epsilon*math.Abs(scaled) {
+				return nil
+			}
+		}
+		return fmt.Errorf(message(MessagePrecision), val, scale)
 	}
 }
 
@@ -110,7 +431,40 @@ func MatchString(val string, r *regexp.Regexp) ValidationFunc {
 		if r.MatchString(val) {
 			return nil
 		}
-		return fmt.Errorf(validateRegex, val)
+		return fmt.Errorf(message(MessageRegex), val)
+	}
+}
+
+// patterns caches regular expressions compiled by MatchPattern keyed by pattern.
+var patterns sync.Map //nolint:gochecknoglobals // cache shared by all MatchPattern calls
+
+// MatchPattern will check that a string, val, matches the regular expression
+// pattern. This suits patterns only known at runtime, such as from config,
+// use MatchString where the pattern is known up front.
+// Compiled patterns are cached so are only compiled once, as the cache is never
+// emptied the set of patterns used should be bounded.
+// An error describing the problem is returned if pattern is invalid.
+func MatchPattern(val, pattern string) ValidationFunc {
+	return func() error {
+		r, ok := patterns.Load(pattern)
+		if !ok {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return fmt.Errorf(message(MessagePattern), pattern, err)
+			}
+			r, _ = patterns.LoadOrStore(pattern, re)
+		}
+		return MatchString(val, r.(*regexp.Regexp))()
+	}
+}
+
+// NotMatchString will check that a string, val, does not match the provided regular expression.
+func NotMatchString(val string, r *regexp.Regexp) ValidationFunc {
+	return func() error {
+		if r.MatchString(val) {
+			return fmt.Errorf(message(MessageNotMatch), val)
+		}
+		return nil
 	}
 }
 
@@ -120,7 +474,33 @@ func MatchBytes(val []byte, r *regexp.Regexp) ValidationFunc {
 		if r.Match(val) {
 			return nil
 		}
-		return fmt.Errorf(validateRegex, val)
+		return fmt.Errorf(message(MessageRegex), val)
+	}
+}
+
+// MatchAny will check that a string, val, matches at least one of the provided
+// regular expressions.
+func MatchAny(val string, rs ...*regexp.Regexp) ValidationFunc {
+	return func() error {
+		for _, r := range rs {
+			if r.MatchString(val) {
+				return nil
+			}
+		}
+		return fmt.Errorf(message(MessageMatchAny), val)
+	}
+}
+
+// MatchAll will check that a string, val, matches every one of the provided
+// regular expressions.
+func MatchAll(val string, rs ...*regexp.Regexp) ValidationFunc {
+	return func() error {
+		for _, r := range rs {
+			if !r.MatchString(val) {
+				return fmt.Errorf(message(MessageRegex), val)
+			}
+		}
+		return nil
 	}
 }
 
@@ -130,7 +510,31 @@ func Equal[T comparable](val, exp T) ValidationFunc {
 		if val == exp {
 			return nil
 		}
-		return fmt.Errorf(validateBool, val, exp)
+		return fmt.Errorf(message(MessageBool), val, exp)
+	}
+}
+
+// EqualOrZero will ensure that val matches exp unless val is the zero value of
+// its type, which is treated as not provided. This suits optional fields that
+// fall back to a default when unset. It shares its message with Equal.
+func EqualOrZero[T comparable](val, exp T) ValidationFunc {
+	return func() error {
+		var zero T
+		if val == zero {
+			return nil
+		}
+		return Equal(val, exp)()
+	}
+}
+
+// Confirm will ensure a confirmation value matches the original, val, such
+// as a confirm password or confirm email field.
+func Confirm[T comparable](val, confirmation T) ValidationFunc {
+	return func() error {
+		if val == confirmation {
+			return nil
+		}
+		return errors.New(message(MessageConfirm))
 	}
 }
 
@@ -140,7 +544,7 @@ func DateEqual(val, exp time.Time) ValidationFunc {
 		if val.Equal(exp) {
 			return nil
 		}
-		return fmt.Errorf(validateDateEqual, val, exp)
+		return fmt.Errorf(message(MessageDateEqual), val, exp)
 	}
 }
 
@@ -150,7 +554,7 @@ func DateAfter(val, exp time.Time) ValidationFunc {
 		if val.After(exp) {
 			return nil
 		}
-		return fmt.Errorf(validateDateAfter, val, exp)
+		return fmt.Errorf(message(MessageDateAfter), val, exp)
 	}
 }
 
@@ -160,142 +564,1311 @@ func DateBefore(val, exp time.Time) ValidationFunc {
 		if val.Before(exp) {
 			return nil
 		}
-		return fmt.Errorf(validateDateBefore, val, exp)
+		return fmt.Errorf(message(MessageDateBefore), val, exp)
 	}
 }
 
-// NotEmpty will ensure that a value, val, is not empty.
-// rules are:
-// int: > 0
-// string: != "" or whitespace
-// slice: not nil and len > 0
-// map: not nil and len > 0
-func NotEmpty(v interface{}) ValidationFunc {
+// DateAfterOrEqual will ensure that a date/time, val, occurs on or after exp.
+func DateAfterOrEqual(val, exp time.Time) ValidationFunc {
 	return func() error {
-		if v == nil {
-			return fmt.Errorf(validateEmpty)
-		}
-		val := reflect.ValueOf(v)
-		valid := false
-		//nolint:exhaustive // not supporting everything
-		switch val.Kind() {
-		case reflect.Map, reflect.Slice:
-			valid = val.Len() > 0 && !val.IsNil()
-		default:
-			valid = !val.IsZero()
-		}
-		if !valid {
-			return fmt.Errorf(validateEmpty)
+		if !val.Before(exp) {
+			return nil
 		}
-		return nil
+		return fmt.Errorf(message(MessageDateAfterEq), val, exp)
 	}
 }
 
-// Empty will ensure that a value, val, is empty.
-// rules are:
-// int: == 0
-// string: == "" or whitespace
-// slice: is nil or len == 0
-// map: is nil and len == 0
-func Empty(v interface{}) ValidationFunc {
+// DateBeforeOrEqual will ensure that a date/time, val, occurs on or before exp.
+func DateBeforeOrEqual(val, exp time.Time) ValidationFunc {
 	return func() error {
-		err := NotEmpty(v)()
-		if err == nil {
-			return fmt.Errorf(validateNotEmpty)
+		if !val.After(exp) {
+			return nil
 		}
-		return nil
+		return fmt.Errorf(message(MessageDateBeforeEq), val, exp)
 	}
 }
 
-// IsNumeric will pass if a string, val, is an Int.
-func IsNumeric(val string) ValidationFunc {
+// DateNotBetween will ensure that a date/time, val, does not fall within the
+// window start to end. The window is inclusive so a val equal to start or end
+// will fail, this is useful for blackout periods.
+// If start is after end they are swapped so a reversed window still applies.
+func DateNotBetween(val, start, end time.Time) ValidationFunc {
 	return func() error {
-		_, err := strconv.Atoi(val)
-		if err == nil {
+		if start.After(end) {
+			start, end = end, start
+		}
+		if val.Before(start) || val.After(end) {
 			return nil
 		}
-		return fmt.Errorf(validateIsNumeric, val)
+		return fmt.Errorf(message(MessageDateNotBetween), val, start, end)
 	}
 }
 
-// UKPostCode will validate that a string, val, is a valid UK PostCode.
-// It does not check the postcode exists, just that it matches an agreed pattern.
-func UKPostCode(val string) ValidationFunc {
+// WithinDuration will ensure that a date/time, val, is no more than d either side
+// of ref. The boundary is inclusive and a negative d is treated as its absolute value.
+func WithinDuration(val, ref time.Time, d time.Duration) ValidationFunc {
 	return func() error {
-		if reUKPostCode.MatchString(val) {
+		if d < 0 {
+			d = -d
+		}
+		// compare against the bounds rather than val.Sub(ref) which saturates
+		// for gaps over roughly 292 years, such as a zero val.
+		if !val.Before(ref.Add(-d)) && !val.After(ref.Add(d)) {
 			return nil
 		}
-		return fmt.Errorf(validateUkPostCode, val)
+		return fmt.Errorf(message(MessageDateWithin), val, d, ref)
 	}
 }
 
-// USZipCode will validate that a string, val, matches a US USZipCode pattern.
-// It does not check the zipcode exists, just that it matches an agreed pattern.
-func USZipCode(val string) ValidationFunc {
+// DateString will ensure that a string, val, can be parsed as a date/time
+// using the supplied layout, such as time.RFC3339 or "2006-01-02".
+func DateString(val, layout string) ValidationFunc {
 	return func() error {
-		if reZipCode.MatchString(val) {
-			return nil
+		if _, err := time.Parse(layout, val); err != nil {
+			return fmt.Errorf(message(MessageDateString), val, layout)
 		}
-		return fmt.Errorf("%s is not a valid UK PostCode", val)
+		return nil
 	}
 }
 
-// HasPrefix ensures string, val, has a prefix matching prefix.
-func HasPrefix(val, prefix string) ValidationFunc {
+// CardExpiry will ensure a card expiry month and year are valid and not in the past.
+// Cards are valid through the end of their expiry month, so the current month passes.
+// Two digit years, such as 29, are treated as being in the current century.
+func CardExpiry(month, year int) ValidationFunc {
 	return func() error {
-		if strings.HasPrefix(val, prefix) {
-			return nil
-		}
-		return fmt.Errorf("value provided does not have a valid prefix")
+		return CardExpiryAt(month, year, time.Now())()
 	}
 }
 
-// NoPrefix ensures a string, val, does not have the supplied prefix.
-func NoPrefix(val, prefix string) ValidationFunc {
+// CardExpiryAt is the same as CardExpiry but checks the expiry against the
+// provided time, now, rather than the current time.
+func CardExpiryAt(month, year int, now time.Time) ValidationFunc {
 	return func() error {
-		if strings.HasPrefix(val, prefix) {
-			return errors.New("value provided does not have a valid prefix")
+		if month < 1 || month > 12 {
+			return fmt.Errorf(message(MessageCardExpiryMonth), month)
+		}
+		if year >= 0 && year < 100 {
+			year += now.Year() / 100 * 100
+		}
+		if year < now.Year() || (year == now.Year() && time.Month(month) < now.Month()) {
+			return fmt.Errorf(message(MessageCardExpired), month, year)
 		}
 		return nil
 	}
 }
 
-// IsHex will check that a string, val, is valid Hexadecimal.
-func IsHex(val string) ValidationFunc {
+// Timezone will ensure that a string, val, is a timezone name that can be
+// loaded by time.LoadLocation, such as "Europe/London", "UTC" or "Local".
+// An empty string will fail even though time.LoadLocation treats it as UTC.
+// The zone database of the host is used, import time/tzdata to embed one.
+func Timezone(val string) ValidationFunc {
 	return func() error {
-		if _, err := hex.DecodeString(val); err != nil {
-			return errors.New("value supplied is not valid hex")
+		if val == "" {
+			return fmt.Errorf(message(MessageTimezone), val)
+		}
+		if _, err := time.LoadLocation(val); err != nil {
+			return fmt.Errorf(message(MessageTimezone), val)
 		}
 		return nil
 	}
 }
 
-// Email will check that a string is a valid email address.
-func Email(val string) ValidationFunc {
+// LanguageTag will validate that a string, val, is a well formed BCP 47
+// language tag such as "en", "en-GB", "zh-Hant" or "es-419".
+// rules are:
+// language: 2 or 3 letters with up to 3 extended language subtags
+// script, region, variants, extensions and private use subtags: optional, in that order
+// private use only tags such as "x-klingon" are also accepted.
+// The reserved 4 letter and registered 5-8 letter language subtags are rejected,
+// so a name such as "english" fails. It does not check the subtags are registered.
+func LanguageTag(val string) ValidationFunc {
 	return func() error {
-		if _, err := mail.ParseAddress(val); err != nil {
-			return errors.New(validateEmail)
+		if reLangTag.MatchString(val) {
+			return nil
+		}
+		return fmt.Errorf(message(MessageLanguageTag), val)
+	}
+}
+
+// SameCalendarDay will ensure that all supplied date/times fall on the same
+// year, month and day. Each time is evaluated in its own location, the index
+// of the first time that differs from the first is reported.
+func SameCalendarDay(times ...time.Time) ValidationFunc {
+	return func() error {
+		if len(times) == 0 {
+			return nil
+		}
+		y, m, d := times[0].Date()
+		for i, t := range times[1:] {
+			ty, tm, td := t.Date()
+			if ty != y || tm != m || td != d {
+				return fmt.Errorf(message(MessageSameDay), i+1)
+			}
 		}
 		return nil
 	}
 }
 
-// Any will check if the provided value is in a set of allowed values.
-func Any[T comparable](val T, vv ...T) ValidationFunc {
+// IsWeekday will ensure that a date/time, val, falls on Monday to Friday.
+func IsWeekday(val time.Time) ValidationFunc {
 	return func() error {
-		for _, v := range vv {
-			if val == v {
+		if !isWeekend(val) {
+			return nil
+		}
+		return fmt.Errorf(message(MessageWeekday), val)
+	}
+}
+
+// IsWeekend will ensure that a date/time, val, falls on a Saturday or Sunday.
+func IsWeekend(val time.Time) ValidationFunc {
+	return func() error {
+		if isWeekend(val) {
+			return nil
+		}
+		return fmt.Errorf(message(MessageWeekend), val)
+	}
+}
+
+// IsWeekdayIn will ensure that a date/time, val, falls on one of the supplied days.
+func IsWeekdayIn(val time.Time, days ...time.Weekday) ValidationFunc {
+	return func() error {
+		for _, d := range days {
+			if val.Weekday() == d {
 				return nil
 			}
 		}
-
-		return errors.New("value not found in allowed values")
+		return fmt.Errorf(message(MessageWeekdayIn), val, days)
 	}
 }
 
-// AnyString will check if the provided string is in a set of allowed values.
-//
-// Deprecated: use Any instead. Will be removed in a future release.
-func AnyString(val string, vv ...string) ValidationFunc {
-	return Any(val, vv...)
+func isWeekend(val time.Time) bool {
+	d := val.Weekday()
+	return d == time.Saturday || d == time.Sunday
+}
+
+// NotEmpty will ensure that a value, val, is not empty.
+// rules are:
+// int: > 0
+// string: != ""
+// slice: not nil and len > 0
+// map: not nil and len > 0
+// pointer: not nil and the value pointed to is not empty
+// A whitespace only string is not empty, use Blank to check for this.
+func NotEmpty(v interface{}) ValidationFunc {
+	return func() error {
+		if v == nil {
+			return errors.New(message(MessageNotEmpty))
+		}
+		val := reflect.ValueOf(v)
+		for val.Kind() == reflect.Ptr {
+			if val.IsNil() {
+				return errors.New(message(MessageNotEmpty))
+			}
+			val = val.Elem()
+		}
+		valid := false
+		//nolint:exhaustive // not supporting everything
+		switch val.Kind() {
+		case reflect.Map, reflect.Slice:
+			valid = val.Len() > 0 && !val.IsNil()
+		default:
+			valid = !val.IsZero()
+		}
+		if !valid {
+			return errors.New(message(MessageNotEmpty))
+		}
+		return nil
+	}
+}
+
+// NotEmptyT will ensure that a value, val, is not the zero value of its type.
+// It avoids the reflection used by NotEmpty so is faster for scalar types,
+// use SliceNotEmpty for slices and NotEmpty for maps and other non comparable types.
+func NotEmptyT[T comparable](val T) ValidationFunc {
+	return func() error {
+		var zero T
+		if val == zero {
+			return errors.New(message(MessageNotEmpty))
+		}
+		return nil
+	}
+}
+
+// SliceNotEmpty will ensure a slice, val, is not nil and has at least one item.
+// It checks len directly so avoids the reflection used by NotEmpty.
+// It shares its message with NotEmpty.
+func SliceNotEmpty[T any](val []T) ValidationFunc {
+	return func() error {
+		if len(val) == 0 {
+			return errors.New(message(MessageNotEmpty))
+		}
+		return nil
+	}
+}
+
+// SliceEmpty will ensure a slice, val, is nil or has no items.
+// It checks len directly so avoids the reflection used by Empty.
+// It shares its message with Empty.
+func SliceEmpty[T any](val []T) ValidationFunc {
+	return func() error {
+		if len(val) != 0 {
+			return errors.New(message(MessageEmpty))
+		}
+		return nil
+	}
+}
+
+// Empty will ensure that a value, val, is empty.
+// rules are:
+// int: == 0
+// string: == ""
+// slice: is nil or len == 0
+// map: is nil and len == 0
+// pointer: is nil or the value pointed to is empty
+// A whitespace only string is not empty, use Blank to check for this.
+func Empty(v interface{}) ValidationFunc {
+	return func() error {
+		err := NotEmpty(v)()
+		if err == nil {
+			return errors.New(message(MessageEmpty))
+		}
+		return nil
+	}
+}
+
+// Blank will ensure a string, val, is empty or only contains whitespace.
+func Blank(val string) ValidationFunc {
+	return func() error {
+		if strings.TrimSpace(val) == "" {
+			return nil
+		}
+		return errors.New(message(MessageBlank))
+	}
+}
+
+// Trimmed will ensure a string, val, has no leading or trailing whitespace.
+// Whitespace within the string is allowed.
+func Trimmed(val string) ValidationFunc {
+	return func() error {
+		if val == strings.TrimSpace(val) {
+			return nil
+		}
+		return errors.New(message(MessageTrimmed))
+	}
+}
+
+// ContainsDigit will ensure a string, val, contains at least one digit.
+func ContainsDigit(val string) ValidationFunc {
+	return containsRune(val, unicode.IsDigit, MessageContainsDigit)
+}
+
+// ContainsUpper will ensure a string, val, contains at least one upper case letter.
+func ContainsUpper(val string) ValidationFunc {
+	return containsRune(val, unicode.IsUpper, MessageContainsUpper)
+}
+
+// ContainsLower will ensure a string, val, contains at least one lower case letter.
+func ContainsLower(val string) ValidationFunc {
+	return containsRune(val, unicode.IsLower, MessageContainsLower)
+}
+
+func containsRune(val string, fn func(rune) bool, key MessageKey) ValidationFunc {
+	return func() error {
+		if strings.IndexFunc(val, fn) >= 0 {
+			return nil
+		}
+		return errors.New(message(key))
+	}
+}
+
+// IsASCII will ensure a string, val, only contains ascii characters,
+// that is runes below 128.
+func IsASCII(val string) ValidationFunc {
+	return onlyRunes(val, func(r rune) bool {
+		return r <= unicode.MaxASCII
+	}, MessageASCII)
+}
+
+// IsPrintableASCII will ensure a string, val, only contains printable
+// ascii characters, that is runes from a space (0x20) to a tilde (0x7E).
+// Control characters such as tabs and newlines will fail.
+func IsPrintableASCII(val string) ValidationFunc {
+	return onlyRunes(val, func(r rune) bool {
+		return r >= ' ' && r <= '~'
+	}, MessagePrintableASCII)
+}
+
+// NoControlChars will ensure a string, val, contains no unicode control
+// characters, as reported by unicode.IsControl. Unlike IsPrintableASCII
+// any other unicode, such as accented letters or emoji, is allowed.
+func NoControlChars(val string) ValidationFunc {
+	return onlyRunes(val, func(r rune) bool {
+		return !unicode.IsControl(r)
+	}, MessageNoControlChars)
+}
+
+// onlyRunes returns a ValidationFunc that fails with the message at key if any
+// rune in val does not satisfy fn.
+func onlyRunes(val string, fn func(rune) bool, key MessageKey) ValidationFunc {
+	return func() error {
+		for _, r := range val {
+			if !fn(r) {
+				return errors.New(message(key))
+			}
+		}
+		return nil
+	}
+}
+
+// IsNumeric will pass if a string, val, is an Int.
+func IsNumeric(val string) ValidationFunc {
+	return func() error {
+		_, err := strconv.Atoi(val)
+		if err == nil {
+			return nil
+		}
+		return fmt.Errorf(message(MessageIsNumeric), val)
+	}
+}
+
+// IsNumericBetween will pass if a string, val, is an Int between min and max
+// inclusive. It parses and range checks in one step, returning a single error
+// when val is not a number, is too large to fit in an int or is out of range.
+func IsNumericBetween(val string, min, max int) ValidationFunc {
+	return func() error {
+		n, err := strconv.Atoi(val)
+		if errors.Is(err, strconv.ErrRange) {
+			return fmt.Errorf(message(MessageNumericOverflow), val)
+		}
+		if err != nil {
+			return fmt.Errorf(message(MessageIsNumeric), val)
+		}
+		return BetweenNumber(n, min, max)()
+	}
+}
+
+// NumericLength will pass if a string, val, only contains the digits 0-9 and is
+// exactly length digits long, such as a fixed length account number. Leading
+// zeros are allowed and count towards the length, signs will fail.
+func NumericLength(val string, length int) ValidationFunc {
+	return func() error {
+		if len(val) == length && strings.Trim(val, "0123456789") == "" {
+			return nil
+		}
+		return fmt.Errorf(message(MessageNumericLength), length)
+	}
+}
+
+// ParseUint will pass if a string, val, is an unsigned int that fits in bitSize
+// bits without overflowing. bitSize should be 0, 8, 16, 32 or 64, where 0 is the
+// size of a uint. Signs, including "-" and "+", will fail.
+func ParseUint(val string, bitSize int) ValidationFunc {
+	return func() error {
+		_, err := strconv.ParseUint(val, 10, bitSize)
+		if errors.Is(err, strconv.ErrRange) {
+			if bitSize == 0 {
+				bitSize = strconv.IntSize
+			}
+			return fmt.Errorf(message(MessageUintOverflow), val, bitSize)
+		}
+		if err != nil {
+			return fmt.Errorf(message(MessageParseUint), val)
+		}
+		return nil
+	}
+}
+
+// IsFloat will pass if a string, val, can be parsed as a float64, this includes
+// integers, decimals and scientific notation such as "1.5e3".
+// NaN, infinity and values too large for a float64 will fail.
+func IsFloat(val string) ValidationFunc {
+	return func() error {
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return fmt.Errorf(message(MessageIsFloat), val)
+		}
+		return nil
+	}
+}
+
+// IsBool will pass if a string, val, can be parsed by strconv.ParseBool.
+// Accepted values are 1, t, T, TRUE, true, True, 0, f, F, FALSE, false and False.
+func IsBool(val string) ValidationFunc {
+	return func() error {
+		if _, err := strconv.ParseBool(val); err != nil {
+			return fmt.Errorf(message(MessageIsBool), val)
+		}
+		return nil
+	}
+}
+
+// UKPostCode will validate that a string, val, is a valid UK PostCode.
+// It does not check the postcode exists, just that it matches an agreed pattern.
+func UKPostCode(val string) ValidationFunc {
+	return func() error {
+		if reUKPostCode.MatchString(val) {
+			return nil
+		}
+		return fmt.Errorf(message(MessageUKPostCode), val)
+	}
+}
+
+// USZipCode will validate that a string, val, matches a US USZipCode pattern.
+// It does not check the zipcode exists, just that it matches an agreed pattern.
+func USZipCode(val string) ValidationFunc {
+	return func() error {
+		if reZipCode.MatchString(val) {
+			return nil
+		}
+		return fmt.Errorf(message(MessageZipCode), val)
+	}
+}
+
+// CAPostalCode will validate that a string, val, matches a Canadian postal code
+// pattern of A1A 1A1, the space is optional. The letters D, F, I, O, Q and U
+// are never used and W and Z are not used as the first letter.
+// It does not check the postal code exists, just that it matches the pattern.
+func CAPostalCode(val string) ValidationFunc {
+	return func() error {
+		if reCAPostCode.MatchString(val) {
+			return nil
+		}
+		return fmt.Errorf(message(MessageCAPostalCode), val)
+	}
+}
+
+// SSN will validate that a string, val, is a US social security number in the
+// format AAA-GG-SSSS or AAAGGSSSS.
+// rules are:
+// area: not 000, 666 or 900-999
+// group: not 00
+// serial: not 0000
+// The value is not included in the error as it is sensitive.
+// It does not check the number has been issued, just that it could be.
+func SSN(val string) ValidationFunc {
+	return func() error {
+		if !reSSN.MatchString(val) {
+			return errors.New(message(MessageSSN))
+		}
+		n := strings.ReplaceAll(val, "-", "")
+		area, group, serial := n[:3], n[3:5], n[5:]
+		if area == "000" || area == "666" || area[0] == '9' || group == "00" || serial == "0000" {
+			return errors.New(message(MessageSSN))
+		}
+		return nil
+	}
+}
+
+// IMEI will validate that a string, val, is a 15 digit IMEI with a valid Luhn
+// check digit. Separators such as spaces or hyphens are not allowed.
+func IMEI(val string) ValidationFunc {
+	return func() error {
+		if NumericLength(val, 15)() != nil || !luhnValid(val) {
+			return errors.New(message(MessageIMEI))
+		}
+		return nil
+	}
+}
+
+// Luhn will validate that a string, val, only contains the digits 0-9 and that
+// its final digit is a valid Luhn check digit. This suits any Luhn protected
+// identifier such as loyalty numbers. Spaces, hyphens and empty strings will fail.
+func Luhn(val string) ValidationFunc {
+	return func() error {
+		if val == "" || strings.Trim(val, "0123456789") != "" || !luhnValid(val) {
+			return errors.New(message(MessageLuhn))
+		}
+		return nil
+	}
+}
+
+// luhnValid reports if a string of digits, val, has a valid Luhn check digit
+// as its final digit. val must only contain the digits 0-9.
+func luhnValid(val string) bool {
+	sum := 0
+	double := false
+	for i := len(val) - 1; i >= 0; i-- {
+		d := int(val[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// PhoneExtension will validate that a string, val, is a phone extension of
+// 3 to 6 digits. A leading zero is only allowed when every digit is zero, ie "000".
+func PhoneExtension(val string) ValidationFunc {
+	return func() error {
+		if reExtension.MatchString(val) {
+			return nil
+		}
+		return fmt.Errorf(message(MessageExtension), val)
+	}
+}
+
+// MACOUI will validate that a string, val, is a 3 octet MAC OUI prefix such as
+// "00:1A:2B". Case is ignored and "-" is accepted as a separator, a full 6 octet
+// MAC address will fail.
+func MACOUI(val string) ValidationFunc {
+	return func() error {
+		if reMACOUI.MatchString(strings.ToUpper(strings.ReplaceAll(val, "-", ":"))) {
+			return nil
+		}
+		return fmt.Errorf(message(MessageMACOUI), val)
+	}
+}
+
+// EthereumAddress will validate that a string, val, is a 0x prefixed, 40 character
+// hex ethereum address. If the address is mixed case the EIP-55 checksum is also
+// checked, all lower or all upper case addresses carry no checksum so are not.
+func EthereumAddress(val string) ValidationFunc {
+	return func() error {
+		if !reEthAddress.MatchString(val) {
+			return fmt.Errorf(message(MessageEthereumAddress), val)
+		}
+		addr := val[2:]
+		lower := strings.ToLower(addr)
+		if addr == lower || addr == strings.ToUpper(addr) {
+			return nil
+		}
+		h := sha3.NewLegacyKeccak256()
+		_, _ = h.Write([]byte(lower))
+		hash := h.Sum(nil)
+		for i, c := range lower {
+			if c < 'a' {
+				continue
+			}
+			// each hex character is checked against the matching nibble of the hash,
+			// the character should be upper case when the nibble is >= 8.
+			nibble := hash[i/2] >> 4
+			if i%2 == 1 {
+				nibble = hash[i/2] & 0x0f
+			}
+			if (nibble >= 8) != (addr[i] < 'a') {
+				return fmt.Errorf(message(MessageEthereumChecksum), val)
+			}
+		}
+		return nil
+	}
+}
+
+// FlagKey will validate that a string, val, is a dotted feature flag key such
+// as "ui.dark_mode". Segments cannot be empty, may only contain a-z, 0-9 and
+// underscores and there can be at most maxDepth segments.
+func FlagKey(val string, maxDepth int) ValidationFunc {
+	return func() error {
+		segments := strings.Split(val, ".")
+		if len(segments) > maxDepth {
+			return fmt.Errorf(message(MessageFlagKey), val)
+		}
+		for _, s := range segments {
+			if !reFlagSegment.MatchString(s) {
+				return fmt.Errorf(message(MessageFlagKey), val)
+			}
+		}
+		return nil
+	}
+}
+
+// HasPrefix ensures string, val, has a prefix matching prefix.
+func HasPrefix(val, prefix string) ValidationFunc {
+	return func() error {
+		if strings.HasPrefix(val, prefix) {
+			return nil
+		}
+		return errors.New(message(MessageHasPrefix))
+	}
+}
+
+// NoPrefix ensures a string, val, does not have the supplied prefix.
+func NoPrefix(val, prefix string) ValidationFunc {
+	return func() error {
+		if strings.HasPrefix(val, prefix) {
+			return errors.New(message(MessageNoPrefix))
+		}
+		return nil
+	}
+}
+
+// IsHex will check that a string, val, is valid Hexadecimal.
+func IsHex(val string) ValidationFunc {
+	return func() error {
+		if _, err := hex.DecodeString(val); err != nil {
+			return errors.New(message(MessageHex))
+		}
+		return nil
+	}
+}
+
+// Email will check that a string is a valid email address.
+func Email(val string) ValidationFunc {
+	return func() error {
+		if _, err := mail.ParseAddress(val); err != nil {
+			return errors.New(message(MessageEmail))
+		}
+		return nil
+	}
+}
+
+// EmailStrict will check that a string, val, is a valid email address whose domain
+// passes Domain, so it must contain a dot and end in a TLD of at least 2 letters.
+// Email is more lenient and accepts addresses such as test@mail.
+func EmailStrict(val string) ValidationFunc {
+	return func() error {
+		domain, err := emailDomain(val)
+		if err != nil {
+			return err
+		}
+		if Domain(domain)() != nil {
+			return errors.New(message(MessageEmail))
+		}
+		return nil
+	}
+}
+
+// EmailWithDomains will check that a string, val, is a valid email address
+// with a domain that is one of allowed. Domains are compared ignoring case.
+func EmailWithDomains(val string, allowed ...string) ValidationFunc {
+	return func() error {
+		domain, err := emailDomain(val)
+		if err != nil {
+			return err
+		}
+		for _, a := range allowed {
+			if strings.EqualFold(domain, a) {
+				return nil
+			}
+		}
+		return fmt.Errorf(message(MessageEmailDomain), domain)
+	}
+}
+
+// EmailExcludingDomains will check that a string, val, is a valid email address
+// with a domain that is not one of blocked. Domains are compared ignoring case.
+func EmailExcludingDomains(val string, blocked ...string) ValidationFunc {
+	return func() error {
+		domain, err := emailDomain(val)
+		if err != nil {
+			return err
+		}
+		for _, b := range blocked {
+			if strings.EqualFold(domain, b) {
+				return fmt.Errorf(message(MessageEmailDomainBlocked), domain)
+			}
+		}
+		return nil
+	}
+}
+
+// emailDomain parses val as an email address and returns its domain,
+// an Email validation error is returned if val cannot be parsed.
+func emailDomain(val string) (string, error) {
+	addr, err := mail.ParseAddress(val)
+	if err != nil {
+		return "", errors.New(message(MessageEmail))
+	}
+	return addr.Address[strings.LastIndexByte(addr.Address, '@')+1:], nil
+}
+
+// Domain will check that a string, val, is a domain name such as example.com.
+// rules are:
+// labels: at least 2, each 1-63 letters, digits or hyphens, not starting or ending with a hyphen
+// final label: at least 2 letters
+// length: at most 253 characters, a trailing dot is not allowed
+// As at least 2 labels are required, single label hosts such as localhost will fail.
+func Domain(val string) ValidationFunc {
+	return func() error {
+		if len(val) > 253 || !reDomain.MatchString(val) {
+			return fmt.Errorf(message(MessageDomain), val)
+		}
+		return nil
+	}
+}
+
+// DataURI will check that a string, val, is a valid RFC 2397 data uri
+// in the form data:[<mediatype>][;base64],<data>.
+// rules are:
+// mediatype: empty or a valid MIME type, parameters are allowed
+// data: valid base64 when ;base64 is present, otherwise valid url encoding
+func DataURI(val string) ValidationFunc {
+	return func() error {
+		if !strings.HasPrefix(val, "data:") {
+			return errors.New(message(MessageDataURI))
+		}
+		header, data, ok := strings.Cut(strings.TrimPrefix(val, "data:"), ",")
+		if !ok {
+			return errors.New(message(MessageDataURI))
+		}
+		isBase64 := strings.HasSuffix(header, ";base64")
+		mediaType := strings.TrimSuffix(header, ";base64")
+		if mediaType != "" && MIMEType(mediaType)() != nil {
+			return errors.New(message(MessageDataURI))
+		}
+		var err error
+		if isBase64 {
+			_, err = base64.StdEncoding.DecodeString(data)
+		} else {
+			_, err = url.PathUnescape(data)
+		}
+		if err != nil {
+			return errors.New(message(MessageDataURI))
+		}
+		return nil
+	}
+}
+
+// MIMEType will check that a string, val, is a syntactically valid MIME type
+// such as application/json or text/plain; charset=utf-8.
+// It does not check the type is registered.
+func MIMEType(val string) ValidationFunc {
+	return func() error {
+		if _, err := parseMIMEType(val); err != nil {
+			return fmt.Errorf(message(MessageMIMEType), val)
+		}
+		return nil
+	}
+}
+
+// MIMETypeIn will check that a string, val, is a valid MIME type that
+// matches one of allowed. Parameters are ignored and matching is case
+// insensitive, so "Text/Plain; charset=utf-8" matches "text/plain".
+func MIMETypeIn(val string, allowed ...string) ValidationFunc {
+	return func() error {
+		mt, err := parseMIMEType(val)
+		if err != nil {
+			return fmt.Errorf(message(MessageMIMEType), val)
+		}
+		for _, a := range allowed {
+			if strings.EqualFold(mt, a) {
+				return nil
+			}
+		}
+		return fmt.Errorf(message(MessageMIMETypeIn), mt, strings.Join(allowed, ", "))
+	}
+}
+
+// parseMIMEType returns the lower case media type of val without parameters.
+// mime.ParseMediaType accepts a lone type, such as "text", so this also
+// ensures both a type and subtype are present.
+func parseMIMEType(val string) (string, error) {
+	mt, _, err := mime.ParseMediaType(val)
+	if err != nil {
+		return "", err
+	}
+	typ, sub, ok := strings.Cut(mt, "/")
+	if !ok || typ == "" || sub == "" {
+		return "", fmt.Errorf("missing subtype in %q", val)
+	}
+	return mt, nil
+}
+
+// Any will check if the provided value is in a set of allowed values.
+func Any[T comparable](val T, vv ...T) ValidationFunc {
+	return func() error {
+		for _, v := range vv {
+			if val == v {
+				return nil
+			}
+		}
+
+		return errors.New(message(MessageAny))
+	}
+}
+
+// In will check if the provided value, val, is in a set of allowed values.
+// It behaves like Any but the error lists the allowed values so clients can
+// see the valid options.
+func In[T comparable](val T, allowed ...T) ValidationFunc {
+	return func() error {
+		for _, a := range allowed {
+			if val == a {
+				return nil
+			}
+		}
+		return fmt.Errorf(message(MessageIn), val, allowed)
+	}
+}
+
+// OneOf will check if a string based value, val, such as a custom enum type
+// is in the set of allowed values. Unlike Any the error lists the allowed values.
+// It is a string constrained form of In and reports the same message.
+//
+//	type Role string
+//
+//	validator.OneOf(role, RoleAdmin, RoleUser)
+func OneOf[T ~string](val T, allowed ...T) ValidationFunc {
+	return In(val, allowed...)
+}
+
+// AnyStringFold will check if the provided string is in a set of allowed values,
+// ignoring case, so "USD" will match an allowed "usd".
+func AnyStringFold(val string, vv ...string) ValidationFunc {
+	return func() error {
+		for _, v := range vv {
+			if strings.EqualFold(val, v) {
+				return nil
+			}
+		}
+		return errors.New(message(MessageAny))
+	}
+}
+
+// SliceContains will check that a slice, val, contains the value want.
+func SliceContains[T comparable](val []T, want T) ValidationFunc {
+	return func() error {
+		for _, v := range val {
+			if v == want {
+				return nil
+			}
+		}
+		return fmt.Errorf(message(MessageSliceContains), want)
+	}
+}
+
+// ContainsAll will check that a slice, val, contains every one of required,
+// all missing values are listed in the error.
+func ContainsAll[T comparable](val []T, required ...T) ValidationFunc {
+	return func() error {
+		have := make(map[T]struct{}, len(val))
+		for _, v := range val {
+			have[v] = struct{}{}
+		}
+		var missing []T
+		for _, r := range required {
+			if _, ok := have[r]; !ok {
+				missing = append(missing, r)
+			}
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+		return fmt.Errorf(message(MessageContainsAll), missing)
+	}
+}
+
+// Subset will check that every item in a slice, val, is one of allowed,
+// the first item not allowed is reported in the error. An empty slice passes.
+func Subset[T comparable](val []T, allowed ...T) ValidationFunc {
+	return func() error {
+		ok := make(map[T]struct{}, len(allowed))
+		for _, a := range allowed {
+			ok[a] = struct{}{}
+		}
+		for _, v := range val {
+			if _, found := ok[v]; !found {
+				return fmt.Errorf(message(MessageSubset), v)
+			}
+		}
+		return nil
+	}
+}
+
+// CountMatching will check that the number of items in a slice, val, for which
+// predicate returns true is between min and max inclusive. The actual count
+// is reported in the error.
+//
+//	validator.CountMatching(addresses, func(a Address) bool { return a.Primary }, 1, 1)
+func CountMatching[T any](val []T, predicate func(T) bool, min, max int) ValidationFunc {
+	return func() error {
+		n := 0
+		for _, v := range val {
+			if predicate(v) {
+				n++
+			}
+		}
+		if n >= min && n <= max {
+			return nil
+		}
+		return fmt.Errorf(message(MessageCountMatching), n, min, max)
+	}
+}
+
+// MapHasKey will check that a map, m, contains the key.
+func MapHasKey[K comparable, V any](m map[K]V, key K) ValidationFunc {
+	return func() error {
+		if _, ok := m[key]; ok {
+			return nil
+		}
+		return fmt.Errorf(message(MessageMapHasKey), key)
+	}
+}
+
+// MapHasKeys will check that a map, m, contains every one of keys.
+// It fails on the first key not found.
+func MapHasKeys[K comparable, V any](m map[K]V, keys ...K) ValidationFunc {
+	return func() error {
+		for _, k := range keys {
+			if err := MapHasKey(m, k)(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// RequiredKeys will check that a decoded map, m, contains every one of keys.
+// Unlike MapHasKeys, all missing keys are listed in a single error.
+// A key that is present with a nil value, such as a JSON null, counts as present.
+func RequiredKeys(m map[string]interface{}, keys ...string) ValidationFunc {
+	return func() error {
+		var missing []string
+		for _, k := range keys {
+			if _, ok := m[k]; !ok {
+				missing = append(missing, k)
+			}
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+		return fmt.Errorf(message(MessageRequiredKeys), strings.Join(missing, ", "))
+	}
+}
+
+// AnyString will check if the provided string is in a set of allowed values.
+//
+// Deprecated: use Any instead. Will be removed in a future release.
+func AnyString(val string, vv ...string) ValidationFunc {
+	return Any(val, vv...)
+}
+
+// AllWordsIn will ensure that every whitespace separated word in a string, val,
+// is present in the allowed set. It fails on the first word not found.
+func AllWordsIn(val string, allowed map[string]struct{}) ValidationFunc {
+	return func() error {
+		for _, w := range strings.Fields(val) {
+			if _, ok := allowed[w]; !ok {
+				return fmt.Errorf(message(MessageAllWordsIn), w)
+			}
+		}
+		return nil
+	}
+}
+
+// NotInWordlist will ensure a string, val, does not contain any of words,
+// ignoring case, the first word found is reported in the error.
+// Words are matched as substrings rather than whole words, so a listed word
+// of "admin" will reject "sysadmin" as well as "admin". Empty words are ignored.
+func NotInWordlist(val string, words ...string) ValidationFunc {
+	return func() error {
+		lower := strings.ToLower(val)
+		for _, w := range words {
+			if w != "" && strings.Contains(lower, strings.ToLower(w)) {
+				return fmt.Errorf(message(MessageNotInWordlist), w)
+			}
+		}
+		return nil
+	}
+}
+
+// TemplatePlaceholdersResolved will ensure that every {{placeholder}} found in
+// a string, val, has a matching key in data. All missing placeholders are listed
+// in the returned error.
+func TemplatePlaceholdersResolved(val string, data map[string]string) ValidationFunc {
+	return func() error {
+		missing := make([]string, 0)
+		seen := map[string]struct{}{}
+		for _, m := range rePlaceholder.FindAllStringSubmatch(val, -1) {
+			key := m[1]
+			if _, ok := data[key]; ok {
+				continue
+			}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			missing = append(missing, key)
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+		return fmt.Errorf(message(MessagePlaceholder), missing)
+	}
+}
+
+// cspNonceMinBytes is the minimum decoded length of a CSP nonce, 128 bits.
+const cspNonceMinBytes = 16
+
+// CSPNonce will ensure a string, val, is a base64 encoded nonce suitable for a
+// Content-Security-Policy header. It must decode, using either the standard or
+// url safe alphabet with or without padding, to at least 16 bytes.
+func CSPNonce(val string) ValidationFunc {
+	return func() error {
+		for _, enc := range []*base64.Encoding{
+			base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding,
+		} {
+			if b, err := enc.DecodeString(val); err == nil && len(b) >= cspNonceMinBytes {
+				return nil
+			}
+		}
+		return errors.New(message(MessageCSPNonce))
+	}
+}
+
+// ClosedRing will ensure a polygon linear ring, points, is valid. Points are
+// expected in GeoJSON order of [longitude, latitude].
+// rules are:
+// at least 4 points
+// first point equals the last point
+// latitude between -90 and 90, longitude between -180 and 180
+func ClosedRing(points [][2]float64) ValidationFunc {
+	return func() error {
+		if len(points) < 4 || points[0] != points[len(points)-1] {
+			return errors.New(message(MessageClosedRing))
+		}
+		for _, p := range points {
+			if !(p[0] >= -180 && p[0] <= 180 && p[1] >= -90 && p[1] <= 90) {
+				return errors.New(message(MessageClosedRing))
+			}
+		}
+		return nil
+	}
+}
+
+// LatLng will ensure a string, val, is a "latitude,longitude" pair such as
+// "51.5074,-0.1278". Spaces around either value are allowed.
+// rules are:
+// latitude between -90 and 90, longitude between -180 and 180
+func LatLng(val string) ValidationFunc {
+	return func() error {
+		lat, lng, ok := strings.Cut(val, ",")
+		if !ok {
+			return fmt.Errorf(message(MessageLatLng), val)
+		}
+		la, err := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+		if err != nil || !(la >= -90 && la <= 90) {
+			return fmt.Errorf(message(MessageLatLng), val)
+		}
+		lo, err := strconv.ParseFloat(strings.TrimSpace(lng), 64)
+		if err != nil || !(lo >= -180 && lo <= 180) {
+			return fmt.Errorf(message(MessageLatLng), val)
+		}
+		return nil
+	}
+}
+
+// EnvVarName will ensure a string, val, is a valid environment variable name,
+// it must start with a letter or underscore followed by letters, digits or underscores.
+func EnvVarName(val string) ValidationFunc {
+	return func() error {
+		if reEnvVarName.MatchString(val) {
+			return nil
+		}
+		return fmt.Errorf(message(MessageEnvVarName), val)
+	}
+}
+
+// EnvOverrides will check every entry in a map, m, of environment overrides.
+// Keys must be valid environment variable names and values cannot contain
+// newlines, any errors are recorded against the offending key.
+func EnvOverrides(m map[string]string) ErrValidation {
+	e := New()
+	for k, v := range m {
+		e.Validate(k, EnvVarName(k), noNewline(v))
+	}
+	return e
+}
+
+func noNewline(val string) ValidationFunc {
+	return func() error {
+		if strings.ContainsAny(val, "\r\n") {
+			return errors.New(message(MessageNoNewline))
+		}
+		return nil
+	}
+}
+
+// Not will invert a ValidationFunc, fn, passing when fn fails and failing when
+// fn passes. As the positive message is not known a generic error is returned.
+//
+//	Validate("name", validator.Not(validator.Empty(name)))
+func Not(fn ValidationFunc) ValidationFunc {
+	return func() error {
+		if err := fn(); err != nil {
+			return nil
+		}
+		return errors.New(message(MessageNot))
+	}
+}
+
+// Or will pass if any of the supplied functions, fns, pass. When all fail
+// their errors are combined into a single error. No functions will fail, like
+// Any with an empty allowed list.
+//
+//	Validate("contact", validator.Or(validator.Email(c), validator.IsNumeric(c)))
+func Or(fns ...ValidationFunc) ValidationFunc {
+	return func() error {
+		errs := make([]string, 0, len(fns))
+		for _, fn := range fns {
+			err := fn()
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err.Error())
+		}
+		return fmt.Errorf(message(MessageOr), strings.Join(errs, ", "))
+	}
+}
+
+// And will pass only if all of the supplied functions, fns, pass. It returns
+// the first error found.
+func And(fns ...ValidationFunc) ValidationFunc {
+	return func() error {
+		for _, fn := range fns {
+			if err := fn(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// When will only evaluate the supplied functions, fns, if cond is true,
+// returning the first error found. If cond is false it will always pass.
+//
+//	Validate("billingAddress", validator.When(!req.SameAsShipping, validator.NotEmpty(req.BillingAddress)))
+func When(cond bool, fns ...ValidationFunc) ValidationFunc {
+	return func() error {
+		if !cond {
+			return nil
+		}
+		return And(fns...)()
+	}
+}
+
+// Unless will only evaluate the supplied functions, fns, if cond is false,
+// it is the inverse of When.
+func Unless(cond bool, fns ...ValidationFunc) ValidationFunc {
+	return When(!cond, fns...)
+}
+
+// RequiredIf will ensure v is not empty, using the same rules as NotEmpty,
+// when cond is true. If cond is false it will always pass.
+//
+//	Validate("shippingAddress", validator.RequiredIf(req.IsPhysical, req.ShippingAddress))
+func RequiredIf(cond bool, v interface{}) ValidationFunc {
+	return When(cond, NotEmpty(v))
+}
+
+// AtLeastOne will ensure at least one of the supplied values, fields, is not empty
+// using the same rules as NotEmpty.
+//
+// The message only states how many values were checked, it does not name them,
+// so the group is named solely by the key it is validated against. Choose a key
+// that names every field in the group, for example:
+//
+//	Validate("email|phone", validator.AtLeastOne(req.Email, req.Phone))
+func AtLeastOne(fields ...interface{}) ValidationFunc {
+	return func() error {
+		for _, f := range fields {
+			if NotEmpty(f)() == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf(message(MessageAtLeastOne), len(fields))
+	}
+}
+
+// Optional will skip the supplied functions, fns, if v is empty using the same
+// rules as NotEmpty, otherwise it returns the first error found. This allows
+// optional fields to be validated only when provided.
+//
+//	Validate("phone", validator.Optional(req.Phone, validator.IsNumeric(req.Phone)))
+func Optional(v interface{}, fns ...ValidationFunc) ValidationFunc {
+	return func() error {
+		if err := NotEmpty(v)(); err != nil {
+			return nil
+		}
+		return And(fns...)()
+	}
+}
+
+// WithMessage will replace the error returned by fn with msg, allowing user
+// friendly messages to be returned. If fn passes nil is returned.
+//
+//	Validate("age", validator.WithMessage(validator.MinNumber(age, 18), "you must be an adult"))
+func WithMessage(fn ValidationFunc, msg string) ValidationFunc {
+	return func() error {
+		if err := fn(); err != nil {
+			return errors.New(msg)
+		}
+		return nil
+	}
+}
+
+// Each will apply the validation function returned by fn to every element
+// of vals. All failures are collected and returned in a single error with
+// the index of each failing element, ie "[2]: value cannot be empty".
+//
+//	Validate("emails", validator.Each(emails, func(e string) validator.ValidationFunc {
+//	    return validator.Email(e)
+//	}))
+func Each[T any](vals []T, fn func(T) ValidationFunc) ValidationFunc {
+	return func() error {
+		errs := make([]string, 0)
+		for i, v := range vals {
+			if err := fn(v)(); err != nil {
+				errs = append(errs, fmt.Sprintf("[%d]: %s", i, err))
+			}
+		}
+		if len(errs) == 0 {
+			return nil
+		}
+		return errors.New(strings.Join(errs, ", "))
+	}
+}
+
+// NoBidiControls will ensure a string, val, contains no unicode bidirectional
+// control characters. These can be used to make text render in a different order
+// to how it is parsed, known as a Trojan Source attack.
+// Rejected characters are:
+// U+061C arabic letter mark
+// U+200E, U+200F left-to-right and right-to-left marks
+// U+202A - U+202E embeddings and overrides
+// U+2066 - U+2069 isolates
+func NoBidiControls(val string) ValidationFunc {
+	return func() error {
+		for _, r := range val {
+			switch {
+			case r == '\u061C', r == '\u200E', r == '\u200F',
+				r >= '\u202A' && r <= '\u202E',
+				r >= '\u2066' && r <= '\u2069':
+				return errors.New(message(MessageNoBidi))
+			}
+		}
+		return nil
+	}
+}
+
+// BackoffConsistent will ensure a retry backoff configuration is usable.
+// rules are:
+// initial: > 0
+// max: > 0 and >= initial
+// multiplier: > 1
+// All problems found are returned in a single error.
+func BackoffConsistent(initial, max time.Duration, multiplier float64) ValidationFunc {
+	return func() error {
+		errs := make([]string, 0)
+		if initial <= 0 {
+			errs = append(errs, message(MessageBackoffInitial))
+		}
+		if max <= 0 {
+			errs = append(errs, message(MessageBackoffMax))
+		}
+		if initial > max {
+			errs = append(errs, fmt.Sprintf(message(MessageBackoffOrder), initial, max))
+		}
+		if multiplier <= 1 {
+			errs = append(errs, fmt.Sprintf(message(MessageBackoffMultiplier), multiplier))
+		}
+		if len(errs) == 0 {
+			return nil
+		}
+		return errors.New(strings.Join(errs, ", "))
+	}
 }
@@ -3,7 +3,10 @@ package validator
 import (
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -55,6 +58,328 @@ func TestLength(t *testing.T) {
 	}
 }
 
+func TestUsername(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"simple name should pass": {
+			val: "theflyingcodr",
+		},
+		"name with underscore hyphen and digits should pass": {
+			val: "fly_ing-codr42",
+		},
+		"too short should fail": {
+			val:    "ab",
+			expErr: fmt.Errorf(validateLength, 3, 20),
+		},
+		"too long should fail": {
+			val:    "abcdefghijklmnopqrstu",
+			expErr: fmt.Errorf(validateLength, 3, 20),
+		},
+		"whitespace should fail": {
+			val:    "flying codr",
+			expErr: errors.New(validateUsernameChars),
+		},
+		"symbols should fail": {
+			val:    "codr@home",
+			expErr: errors.New(validateUsernameChars),
+		},
+		"leading digit should fail": {
+			val:    "1codr",
+			expErr: errors.New(validateUsernameDigit),
+		},
+		"all failing rules should be returned": {
+			val:    "1!",
+			expErr: errors.New(fmt.Sprintf(validateLength, 3, 20) + ", " + validateUsernameChars + ", " + validateUsernameDigit),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Username(test.val, 3, 20)())
+		})
+	}
+}
+
+func TestStrLengthRunes(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		s         string
+		minLen    int
+		maxLen    int
+		expErr    error
+		expByteOK bool
+	}{
+		"accented string within rune length should pass": {
+			s:      "héllo",
+			minLen: 1,
+			maxLen: 5,
+		},
+		"emoji string within rune length should pass": {
+			s:      "👍👍",
+			minLen: 1,
+			maxLen: 2,
+		},
+		"ascii string should match byte length": {
+			s:         "hello",
+			minLen:    1,
+			maxLen:    5,
+			expByteOK: true,
+		},
+		"string too large should fail": {
+			s:      "héllo!",
+			minLen: 1,
+			maxLen: 5,
+			expErr: fmt.Errorf(validateLength, 1, 5),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, StrLengthRunes(test.s, test.minLen, test.maxLen)())
+			is.Equal(test.expByteOK, StrLength(test.s, test.minLen, test.maxLen)() == nil)
+		})
+	}
+}
+
+func TestByteLength(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"ascii string within limit should pass": {
+			val: "cafe",
+		},
+		"multibyte string within byte limit should pass": {
+			val: "café",
+		},
+		"multibyte string over byte limit should fail": {
+			val:    "ééé",
+			expErr: fmt.Errorf(validateByteLength, 2, 5),
+		},
+		"too short should fail": {
+			val:    "a",
+			expErr: fmt.Errorf(validateByteLength, 2, 5),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, ByteLength(test.val, 2, 5)())
+		})
+	}
+	// "ééé" is 3 runes but 6 bytes, so only the rune count is within 2 to 5.
+	is.NoErr(StrLengthRunes("ééé", 2, 5)())
+	is.Equal(fmt.Errorf(validateByteLength, 2, 5), ByteLength("ééé", 2, 5)())
+}
+
+func TestStrLengthExactRunes(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		s      string
+		length int
+		expErr error
+	}{
+		"accented string of exact rune length should pass": {
+			s:      "héllo",
+			length: 5,
+		},
+		"emoji string of exact rune length should pass": {
+			s:      "👍👍",
+			length: 2,
+		},
+		"byte length should fail": {
+			s:      "👍👍",
+			length: 8,
+			expErr: fmt.Errorf(validateExactLength, 8),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, StrLengthExactRunes(test.s, test.length)())
+		})
+	}
+}
+
+func TestSliceLength(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    []string
+		min    int
+		max    int
+		expErr error
+	}{
+		"slice within bounds should pass": {
+			val: []string{"a", "b"},
+			min: 1,
+			max: 50,
+		},
+		"empty slice below min should fail": {
+			val:    []string{},
+			min:    1,
+			max:    50,
+			expErr: fmt.Errorf(validateSliceLength, 1, 50),
+		},
+		"nil slice allowed by min should pass": {
+			min: 0,
+			max: 50,
+		},
+		"oversized slice should fail": {
+			val:    []string{"a", "b", "c"},
+			min:    1,
+			max:    2,
+			expErr: fmt.Errorf(validateSliceLength, 1, 2),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, SliceLength(test.val, test.min, test.max)())
+		})
+	}
+}
+
+func TestSliceLengthExact(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    []int
+		length int
+		expErr error
+	}{
+		"slice of exact length should pass": {
+			val:    []int{1, 2, 3},
+			length: 3,
+		},
+		"empty slice should fail": {
+			val:    []int{},
+			length: 3,
+			expErr: fmt.Errorf(validateSliceExactLength, 3),
+		},
+		"oversized slice should fail": {
+			val:    []int{1, 2, 3, 4},
+			length: 3,
+			expErr: fmt.Errorf(validateSliceExactLength, 3),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, SliceLengthExact(test.val, test.length)())
+		})
+	}
+}
+
+func TestMinItems(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    []string
+		min    int
+		expErr error
+	}{
+		"slice at min should pass": {
+			val: []string{"a"},
+			min: 1,
+		},
+		"slice above min should pass": {
+			val: []string{"a", "b"},
+			min: 1,
+		},
+		"nil slice should fail": {
+			min:    1,
+			expErr: fmt.Errorf(validateMinItems, 1),
+		},
+		"nil slice with zero min should pass": {
+			min: 0,
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, MinItems(test.val, test.min)())
+		})
+	}
+}
+
+func TestMaxItems(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    []string
+		max    int
+		expErr error
+	}{
+		"slice at max should pass": {
+			val: []string{"a", "b"},
+			max: 2,
+		},
+		"nil slice should pass": {
+			max: 2,
+		},
+		"slice above max should fail": {
+			val:    []string{"a", "b", "c"},
+			max:    2,
+			expErr: fmt.Errorf(validateMaxItems, 2),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, MaxItems(test.val, test.max)())
+		})
+	}
+}
+
+func TestSorted(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val       []int
+		ascending bool
+		expErr    error
+	}{
+		"ascending slice should pass": {
+			val:       []int{1, 2, 2, 5},
+			ascending: true,
+		},
+		"descending slice should pass": {
+			val: []int{5, 2, 2, 1},
+		},
+		"empty slice should pass": {
+			val:       []int{},
+			ascending: true,
+		},
+		"single item slice should pass": {
+			val: []int{1},
+		},
+		"unsorted ascending slice should fail": {
+			val:       []int{1, 3, 2, 5},
+			ascending: true,
+			expErr:    fmt.Errorf(validateSorted, "ascending", 2),
+		},
+		"ascending slice checked as descending should fail": {
+			val:    []int{1, 2},
+			expErr: fmt.Errorf(validateSorted, "descending", 1),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Sorted(test.val, test.ascending)())
+		})
+	}
+}
+
 func TestMinInt(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
@@ -315,693 +640,3826 @@ func TestPositiveInt64(t *testing.T) {
 	}
 }
 
-func TestMatchString(t *testing.T) {
+func TestNonNegative(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
 	tt := map[string]struct {
-		s      string
-		r      *regexp.Regexp
+		i      int
 		expErr error
 	}{
-		"string that matches should pass": {
-			s: "hi there",
-			r: regexp.MustCompile("[a-z ]*"),
+		"positive should pass": {
+			i: 1,
 		},
-		"string that matches list should pass": {
-			s: "pass",
-			r: regexp.MustCompile(`(pass|fail)`),
+		"zero should pass": {
+			i: 0,
 		},
-		"string that doesn't match should fail": {
-			s:      "oops",
-			r:      regexp.MustCompile(`(pass|fail)`),
-			expErr: fmt.Errorf(validateRegex, "oops"),
+		"negative should fail": {
+			i:      -1,
+			expErr: fmt.Errorf(validateNonNegative, -1),
 		},
 	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, MatchString(test.s, test.r)())
+			is.Equal(test.expErr, NonNegative(test.i)())
 		})
 	}
 }
 
-func TestMatchBytes(t *testing.T) {
+func TestNonPositive(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
 	tt := map[string]struct {
-		s      []byte
-		r      *regexp.Regexp
+		i      float64
 		expErr error
 	}{
-		"string that matches should pass": {
-			s: []byte("hi there"),
-			r: regexp.MustCompile("[a-z ]*"),
+		"negative should pass": {
+			i: -1,
 		},
-		"string that matches list should pass": {
-			s: []byte("pass"),
-			r: regexp.MustCompile(`(pass|fail)`),
+		"zero should pass": {
+			i: 0,
 		},
-		"string that doesn't match should fail": {
-			s:      []byte("oops"),
-			r:      regexp.MustCompile(`(pass|fail)`),
-			expErr: fmt.Errorf(validateRegex, "oops"),
+		"positive should fail": {
+			i:      1,
+			expErr: fmt.Errorf(validateNonPositive, 1.0),
 		},
 	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, MatchBytes(test.s, test.r)())
+			is.Equal(test.expErr, NonPositive(test.i)())
 		})
 	}
 }
 
-func TestEqualBool(t *testing.T) {
+func TestNumberInInt(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
 	tt := map[string]struct {
-		val    bool
-		exp    bool
-		expErr error
+		val     int
+		allowed []int
+		expErr  error
 	}{
-		"val matching exp should pass": {
-			val: true,
-			exp: true,
-		}, "val matching false exp should pass": {
-			val: false,
-			exp: false,
-		}, "val not matching exp should fail": {
-			val:    true,
-			exp:    false,
-			expErr: fmt.Errorf(validateBool, true, false),
+		"allowed value should pass": {
+			val:     25,
+			allowed: []int{10, 25, 50},
+		},
+		"disallowed value should fail": {
+			val:     20,
+			allowed: []int{10, 25, 50},
+			expErr:  fmt.Errorf(validateIn, 20, []int{10, 25, 50}),
+		},
+		"empty allowed list should fail": {
+			val:    20,
+			expErr: fmt.Errorf(validateIn, 20, []int(nil)),
 		},
 	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, Equal(test.val, test.exp)())
+			is.Equal(test.expErr, NumberIn(test.val, test.allowed...)())
 		})
 	}
 }
 
-func TestEqualString(t *testing.T) {
+func TestNumberInFloat(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	is.NoErr(NumberIn(0.5, 0.25, 0.5, 0.75)())
+	is.Equal(fmt.Errorf(validateIn, 0.6, []float64{0.25, 0.5}), NumberIn(0.6, 0.25, 0.5)())
+	is.Equal("value 0.6 must be one of [0.25 0.5]", NumberIn(0.6, 0.25, 0.5)().Error())
+}
+
+func TestAtMostPercentOf(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
 	tt := map[string]struct {
-		val    string
-		exp    string
-		expErr error
+		val     float64
+		base    float64
+		percent float64
+		expErr  error
 	}{
-		"val matching exp should pass": {
-			val: "hi there, this is a test!",
-			exp: "hi there, this is a test!",
-		}, "val not matching exp should fail": {
-			val:    "hi there, this is a test",
-			exp:    "hi there, this is a test! but i'm different",
-			expErr: fmt.Errorf(validateBool, "hi there, this is a test", "hi there, this is a test! but i'm different"),
+		"value below cap should pass": {
+			val:     10,
+			base:    100,
+			percent: 50,
+		},
+		"value at cap should pass": {
+			val:     50,
+			base:    100,
+			percent: 50,
+		},
+		"value above cap should fail": {
+			val:     50.01,
+			base:    100,
+			percent: 50,
+			expErr:  fmt.Errorf(validatePercentOf, 50.01, 50.0, 100.0),
 		},
 	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, Equal(test.val, test.exp)())
+			is.Equal(test.expErr, AtMostPercentOf(test.val, test.base, test.percent)())
 		})
 	}
 }
 
-func TestEqualInt(t *testing.T) {
+func TestOrderQuantity(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
 	tt := map[string]struct {
-		val    int
-		exp    int
-		expErr error
+		qty      int
+		minOrder int
+		packSize int
+		expErr   error
 	}{
-		"val matching exp should pass": {
-			val: 1234,
-			exp: 1234,
-		}, "val not matching exp should fail": {
-			val:    1234,
-			exp:    433321,
-			expErr: fmt.Errorf(validateBool, 1234, 433321),
+		"valid quantity should pass": {
+			qty:      12,
+			minOrder: 6,
+			packSize: 6,
+		},
+		"zero pack size should only check minimum": {
+			qty:      7,
+			minOrder: 6,
+		},
+		"quantity below minimum should fail": {
+			qty:      6,
+			minOrder: 12,
+			packSize: 6,
+			expErr:   errors.New(fmt.Sprintf(validateOrderMin, 6, 12)),
+		},
+		"quantity not a pack multiple should fail": {
+			qty:      13,
+			minOrder: 6,
+			packSize: 6,
+			expErr:   errors.New(fmt.Sprintf(validateOrderPack, 13, 6)),
+		},
+		"both rules failing should report both": {
+			qty:      5,
+			minOrder: 6,
+			packSize: 6,
+			expErr: errors.New(fmt.Sprintf(validateOrderMin, 5, 6) + ", " +
+				fmt.Sprintf(validateOrderPack, 5, 6)),
 		},
 	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, Equal(test.val, test.exp)())
+			is.Equal(test.expErr, OrderQuantity(test.qty, test.minOrder, test.packSize)())
 		})
 	}
 }
 
-func TestEqualDate(t *testing.T) {
+func TestNoExcessPrecision(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
+	// computed at runtime so the sum carries binary noise, 0.30000000000000004.
+	a, b := 0.1, 0.2
 	tt := map[string]struct {
-		val    time.Time
-		exp    time.Time
+		val    float64
+		scale  int
 		expErr error
 	}{
-		"val matching exp should pass": {
-			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-		}, "val not matching exp should fail": {
-			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-			exp: time.Date(2021, 1, 1, 1, 1, 1, 2, time.UTC),
-			expErr: fmt.Errorf(validateBool,
-				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-				time.Date(2021, 1, 1, 1, 1, 1, 2, time.UTC)),
+		"value within scale should pass": {
+			val:   1.50,
+			scale: 2,
+		},
+		"whole number should pass": {
+			val:   3,
+			scale: 0,
+		},
+		"value exceeding scale should fail": {
+			val:    1.555,
+			scale:  2,
+			expErr: fmt.Errorf(validatePrecision, 1.555, 2),
+		},
+		"currency value should pass": {
+			val:   99999.99,
+			scale: 2,
+		},
+		"computed value with binary noise should pass": {
+			val:   a + b,
+			scale: 2,
+		},
+		"large whole number should pass": {
+			val:   math.MaxFloat64,
+			scale: 2,
+		},
+		"NaN should fail": {
+			val:    math.NaN(),
+			scale:  2,
+			expErr: fmt.Errorf(validatePrecision, math.NaN(), 2),
 		},
 	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, Equal(test.val, test.exp)())
+			is.Equal(test.expErr, NoExcessPrecision(test.val, test.scale)())
 		})
 	}
 }
 
-func TestDateEqual(t *testing.T) {
+func TestNoExcessPrecisionFloat32(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
 	tt := map[string]struct {
-		val    time.Time
-		exp    time.Time
+		val    float32
+		scale  int
 		expErr error
 	}{
-		"date matching should pass": {
-			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+		"0.1 at scale 1 should pass": {
+			val:   0.1,
+			scale: 1,
 		},
-		"date not matching should fail": {
-			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-			exp: time.Date(2021, 1, 1, 1, 1, 1, 2, time.UTC),
-			expErr: fmt.Errorf(validateDateEqual,
-				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-				time.Date(2021, 1, 1, 1, 1, 1, 2, time.UTC)),
+		"0.15 at scale 1 should fail": {
+			val:    0.15,
+			scale:  1,
+			expErr: fmt.Errorf(validatePrecision, float32(0.15), 1),
+		},
+		"19.99 at scale 2 should pass": {
+			val:   19.99,
+			scale: 2,
+		},
+		"1.15 at scale 2 should pass": {
+			val:   1.15,
+			scale: 2,
+		},
+		"123.45 at scale 2 should pass": {
+			val:   123.45,
+			scale: 2,
+		},
+		"1234.56 at scale 2 should pass": {
+			val:   1234.56,
+			scale: 2,
+		},
+		"99999.99 at scale 2 should pass": {
+			val:   99999.99,
+			scale: 2,
+		},
+		"19.999 at scale 2 should fail": {
+			val:    19.999,
+			scale:  2,
+			expErr: fmt.Errorf(validatePrecision, float32(19.999), 2),
 		},
 	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, DateEqual(test.val, test.exp)())
+			is.Equal(test.expErr, NoExcessPrecision(test.val, test.scale)())
 		})
 	}
 }
 
-func TestDateBefore(t *testing.T) {
+func TestMatchString(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
 	tt := map[string]struct {
-		val    time.Time
-		exp    time.Time
+		s      string
+		r      *regexp.Regexp
 		expErr error
 	}{
-		"date before should pass": {
-			val: time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC),
-			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+		"string that matches should pass": {
+			s: "hi there",
+			r: regexp.MustCompile("[a-z ]*"),
 		},
-		"date matching exp should fail": {
-			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-			expErr: fmt.Errorf(validateDateBefore,
-				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC)),
+		"string that matches list should pass": {
+			s: "pass",
+			r: regexp.MustCompile(`(pass|fail)`),
 		},
-		"date after exp should fail": {
-			val: time.Date(2022, 1, 1, 1, 1, 1, 1, time.UTC),
-			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-			expErr: fmt.Errorf(validateDateBefore,
-				time.Date(2022, 1, 1, 1, 1, 1, 1, time.UTC),
-				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC)),
+		"string that doesn't match should fail": {
+			s:      "oops",
+			r:      regexp.MustCompile(`(pass|fail)`),
+			expErr: fmt.Errorf(validateRegex, "oops"),
 		},
 	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, DateBefore(test.val, test.exp)())
+			is.Equal(test.expErr, MatchString(test.s, test.r)())
 		})
 	}
 }
 
-func TestDateAfter(t *testing.T) {
+func TestMatchPattern(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
+	_, compileErr := regexp.Compile(`[a-z`)
 	tt := map[string]struct {
-		val    time.Time
-		exp    time.Time
-		expErr error
+		val     string
+		pattern string
+		expErr  error
 	}{
-		"date after should pass": {
-			val: time.Date(2021, 1, 1, 1, 1, 1, 2, time.UTC),
-			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+		"matching value should pass": {
+			val:     "abc123",
+			pattern: `^[a-z]+\d+$`,
 		},
-		"date matching exp should fail": {
-			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-			expErr: fmt.Errorf(validateDateAfter,
-				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC)),
+		"non matching value should fail": {
+			val:     "123abc",
+			pattern: `^[a-z]+\d+$`,
+			expErr:  fmt.Errorf(validateRegex, "123abc"),
 		},
-		"date before exp should fail": {
-			val: time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC),
-			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
-			expErr: fmt.Errorf(validateDateAfter,
-				time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC),
-				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC)),
+		"invalid pattern should fail": {
+			val:     "abc",
+			pattern: `[a-z`,
+			expErr:  fmt.Errorf(validatePattern, `[a-z`, compileErr),
 		},
 	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, DateAfter(test.val, test.exp)())
+			is.Equal(test.expErr, MatchPattern(test.val, test.pattern)())
+			// the second call uses the cached pattern.
+			is.Equal(test.expErr, MatchPattern(test.val, test.pattern)())
 		})
 	}
 }
 
-func TestIsNumeric(t *testing.T) {
+func TestNotMatchString(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
 	tt := map[string]struct {
-		val    string
+		s      string
+		r      *regexp.Regexp
 		expErr error
 	}{
-		"valid number should pass": {
-			val: "12345",
-		},
-		"valid negative number should pass": {
-			val: "-12345",
+		"string that doesn't match should pass": {
+			s: "my_username",
+			r: regexp.MustCompile(`^(admin|root)`),
 		},
-		"invalid number should fail": {
-			val:    "12345a",
-			expErr: fmt.Errorf(validateIsNumeric, "12345a"),
+		"string that matches should fail": {
+			s:      "admin_bob",
+			r:      regexp.MustCompile(`^(admin|root)`),
+			expErr: fmt.Errorf(validateNotMatch, "admin_bob"),
 		},
 	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, IsNumeric(test.val)())
+			is.Equal(test.expErr, NotMatchString(test.s, test.r)())
 		})
 	}
 }
 
-func TestUKPostCode(t *testing.T) {
+func TestMatchBytes(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
 	tt := map[string]struct {
-		val    []string
+		s      []byte
+		r      *regexp.Regexp
 		expErr error
 	}{
-		"Valid postcodes should pass": {
-			val: []string{"bt13 4GH", "NW1A 1AA", "A9A 9AA", "A9 9AA", "A99 9AA"},
+		"string that matches should pass": {
+			s: []byte("hi there"),
+			r: regexp.MustCompile("[a-z ]*"),
 		},
-		"Invalid postcodes should fail": {
-			val:    []string{"GGG 7GH", "NW1A 1A", "N1 GF", "N11 DDD"},
-			expErr: fmt.Errorf(validateUkPostCode, "GGG 7GH"),
+		"string that matches list should pass": {
+			s: []byte("pass"),
+			r: regexp.MustCompile(`(pass|fail)`),
+		},
+		"string that doesn't match should fail": {
+			s:      []byte("oops"),
+			r:      regexp.MustCompile(`(pass|fail)`),
+			expErr: fmt.Errorf(validateRegex, "oops"),
 		},
 	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			for _, p := range test.val {
-				err := UKPostCode(p)()
-				if test.expErr == nil {
-					is.NoErr(err)
-					continue
-				}
-				is.Equal(err != nil, true)
-			}
+			is.Equal(test.expErr, MatchBytes(test.s, test.r)())
 		})
 	}
 }
 
-func TestZipCode(t *testing.T) {
+func TestMatchAny(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
+	rs := []*regexp.Regexp{regexp.MustCompile(`^[a-z]+$`), regexp.MustCompile(`^\d+$`)}
 	tt := map[string]struct {
-		val    []string
+		s      string
 		expErr error
 	}{
-		"Valid zipcodes should pass": {
-			val: []string{"57501", "17101", "12201-7050", "99750-0077"},
+		"string matching first pattern should pass": {
+			s: "hello",
 		},
-		"Invalid zipcodes should fail": {
-			val:    []string{"GGG 7GH", "99750-00", "99750-0", "99750-", "1111"},
-			expErr: fmt.Errorf(validateUkPostCode, "GGG 7GH"),
+		"string matching second pattern should pass": {
+			s: "12345",
+		},
+		"string matching no patterns should fail": {
+			s:      "hello123",
+			expErr: fmt.Errorf(validateMatchAny, "hello123"),
 		},
 	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			for _, p := range test.val {
-				err := USZipCode(p)()
-				if test.expErr == nil {
-					is.NoErr(err)
-					continue
-				}
-				is.Equal(err != nil, true)
-			}
+			is.Equal(test.expErr, MatchAny(test.s, rs...)())
 		})
 	}
 }
 
-func TestEmail(t *testing.T) {
+func TestMatchAll(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
+	rs := []*regexp.Regexp{regexp.MustCompile(`^[a-z]`), regexp.MustCompile(`\d$`)}
 	tt := map[string]struct {
-		val    string
+		s      string
 		expErr error
 	}{
-		"email without a domain should fail": {
-			val:    "test@",
-			expErr: fmt.Errorf(validateEmail),
+		"string matching all patterns should pass": {
+			s: "abc1",
 		},
-		"email without a prefix": {
-			val:    "@test.com",
-			expErr: fmt.Errorf(validateEmail),
+		"string matching one pattern should fail": {
+			s:      "abc",
+			expErr: fmt.Errorf(validateRegex, "abc"),
 		},
-		"emails are not required to have a tld so will pass": {
-			val: "test@mail",
+		"string matching no patterns should fail": {
+			s:      "1abc",
+			expErr: fmt.Errorf(validateRegex, "1abc"),
 		},
 	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, Email(test.val)())
+			is.Equal(test.expErr, MatchAll(test.s, rs...)())
 		})
 	}
 }
 
-func TestNotEmpty(t *testing.T) {
+func TestEqualBool(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
 	tt := map[string]struct {
-		val    interface{}
+		val    bool
+		exp    bool
 		expErr error
 	}{
-		"nil ptr": {
-			val:    nil,
-			expErr: errors.New(validateEmpty),
-		},
-		"non-empty string": {
-			val: "hello",
+		"val matching exp should pass": {
+			val: true,
+			exp: true,
+		}, "val matching false exp should pass": {
+			val: false,
+			exp: false,
+		}, "val not matching exp should fail": {
+			val:    true,
+			exp:    false,
+			expErr: fmt.Errorf(validateBool, true, false),
 		},
-		"empty string": {
-			val:    "",
-			expErr: errors.New(validateEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Equal(test.val, test.exp)())
+		})
+	}
+}
+
+func TestEqualString(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		exp    string
+		expErr error
+	}{
+		"val matching exp should pass": {
+			val: "hi there, this is a test!",
+			exp: "hi there, this is a test!",
+		}, "val not matching exp should fail": {
+			val:    "hi there, this is a test",
+			exp:    "hi there, this is a test! but i'm different",
+			expErr: fmt.Errorf(validateBool, "hi there, this is a test", "hi there, this is a test! but i'm different"),
 		},
-		"non-empty time": {
-			val: time.Now(),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Equal(test.val, test.exp)())
+		})
+	}
+}
+
+func TestEqualInt(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    int
+		exp    int
+		expErr error
+	}{
+		"val matching exp should pass": {
+			val: 1234,
+			exp: 1234,
+		}, "val not matching exp should fail": {
+			val:    1234,
+			exp:    433321,
+			expErr: fmt.Errorf(validateBool, 1234, 433321),
 		},
-		"empty time": {
-			val:    time.Time{},
-			expErr: errors.New(validateEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Equal(test.val, test.exp)())
+		})
+	}
+}
+
+func TestEqualDate(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    time.Time
+		exp    time.Time
+		expErr error
+	}{
+		"val matching exp should pass": {
+			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+		}, "val not matching exp should fail": {
+			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 2, time.UTC),
+			expErr: fmt.Errorf(validateBool,
+				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+				time.Date(2021, 1, 1, 1, 1, 1, 2, time.UTC)),
 		},
-		"non-empty int": {
-			val: 235,
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Equal(test.val, test.exp)())
+		})
+	}
+}
+
+func TestEqualOrZero(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    int
+		exp    int
+		expErr error
+	}{
+		"equal value should pass": {
+			val: 30,
+			exp: 30,
+		},
+		"zero value should pass": {
+			val: 0,
+			exp: 30,
+		},
+		"different non zero value should fail": {
+			val:    15,
+			exp:    30,
+			expErr: fmt.Errorf(validateBool, 15, 30),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, EqualOrZero(test.val, test.exp)())
+		})
+	}
+	is.NoErr(EqualOrZero("", "GBP")())
+	is.Equal(fmt.Errorf(validateBool, "USD", "GBP"), EqualOrZero("USD", "GBP")())
+}
+
+func TestConfirm(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val          string
+		confirmation string
+		expErr       error
+	}{
+		"matching confirmation should pass": {
+			val:          "s3cr3t!",
+			confirmation: "s3cr3t!",
+		},
+		"mismatching confirmation should fail": {
+			val:          "s3cr3t!",
+			confirmation: "s3cr3t",
+			expErr:       errors.New(validateConfirm),
+		},
+		"confirmation is case sensitive": {
+			val:          "test@test.com",
+			confirmation: "Test@test.com",
+			expErr:       errors.New(validateConfirm),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Confirm(test.val, test.confirmation)())
+		})
+	}
+}
+
+func TestDateEqual(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    time.Time
+		exp    time.Time
+		expErr error
+	}{
+		"date matching should pass": {
+			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+		},
+		"date not matching should fail": {
+			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 2, time.UTC),
+			expErr: fmt.Errorf(validateDateEqual,
+				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+				time.Date(2021, 1, 1, 1, 1, 1, 2, time.UTC)),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, DateEqual(test.val, test.exp)())
+		})
+	}
+}
+
+func TestDateBefore(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    time.Time
+		exp    time.Time
+		expErr error
+	}{
+		"date before should pass": {
+			val: time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+		},
+		"date matching exp should fail": {
+			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			expErr: fmt.Errorf(validateDateBefore,
+				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC)),
+		},
+		"date after exp should fail": {
+			val: time.Date(2022, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			expErr: fmt.Errorf(validateDateBefore,
+				time.Date(2022, 1, 1, 1, 1, 1, 1, time.UTC),
+				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC)),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, DateBefore(test.val, test.exp)())
+		})
+	}
+}
+
+func TestDateAfter(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    time.Time
+		exp    time.Time
+		expErr error
+	}{
+		"date after should pass": {
+			val: time.Date(2021, 1, 1, 1, 1, 1, 2, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+		},
+		"date matching exp should fail": {
+			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			expErr: fmt.Errorf(validateDateAfter,
+				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC)),
+		},
+		"date before exp should fail": {
+			val: time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			expErr: fmt.Errorf(validateDateAfter,
+				time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC),
+				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC)),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, DateAfter(test.val, test.exp)())
+		})
+	}
+}
+
+func TestDateAfterOrEqual(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    time.Time
+		exp    time.Time
+		expErr error
+	}{
+		"date after should pass": {
+			val: time.Date(2021, 1, 1, 1, 1, 1, 2, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+		},
+		"date matching exp should pass": {
+			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+		},
+		"date before exp should fail": {
+			val: time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			expErr: fmt.Errorf(validateDateAfterEq,
+				time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC),
+				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC)),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, DateAfterOrEqual(test.val, test.exp)())
+		})
+	}
+}
+
+func TestDateBeforeOrEqual(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    time.Time
+		exp    time.Time
+		expErr error
+	}{
+		"date before should pass": {
+			val: time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+		},
+		"date matching exp should pass": {
+			val: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+		},
+		"date after exp should fail": {
+			val: time.Date(2022, 1, 1, 1, 1, 1, 1, time.UTC),
+			exp: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC),
+			expErr: fmt.Errorf(validateDateBeforeEq,
+				time.Date(2022, 1, 1, 1, 1, 1, 1, time.UTC),
+				time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC)),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, DateBeforeOrEqual(test.val, test.exp)())
+		})
+	}
+}
+
+func TestDateNotBetween(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	start := time.Date(2021, 12, 24, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 12, 27, 0, 0, 0, 0, time.UTC)
+	tt := map[string]struct {
+		val    time.Time
+		expErr error
+	}{
+		"date before window should pass": {
+			val: start.Add(-time.Nanosecond),
+		},
+		"date after window should pass": {
+			val: end.Add(time.Nanosecond),
+		},
+		"date inside window should fail": {
+			val:    time.Date(2021, 12, 25, 0, 0, 0, 0, time.UTC),
+			expErr: fmt.Errorf(validateDateNotBetween, time.Date(2021, 12, 25, 0, 0, 0, 0, time.UTC), start, end),
+		},
+		"date on start should fail": {
+			val:    start,
+			expErr: fmt.Errorf(validateDateNotBetween, start, start, end),
+		},
+		"date on end should fail": {
+			val:    end,
+			expErr: fmt.Errorf(validateDateNotBetween, end, start, end),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, DateNotBetween(test.val, start, end)())
+		})
+	}
+	// a reversed window should be swapped rather than blocking nothing.
+	inside := time.Date(2021, 12, 25, 0, 0, 0, 0, time.UTC)
+	is.Equal(fmt.Errorf(validateDateNotBetween, inside, start, end), DateNotBetween(inside, end, start)())
+	is.NoErr(DateNotBetween(end.Add(time.Nanosecond), end, start)())
+}
+
+func TestWithinDuration(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	ref := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	tt := map[string]struct {
+		val    time.Time
+		d      time.Duration
+		expErr error
+	}{
+		"date inside duration should pass": {
+			val: ref.Add(-30 * time.Second),
+			d:   time.Minute,
+		},
+		"date on boundary should pass": {
+			val: ref.Add(time.Minute),
+			d:   time.Minute,
+		},
+		"negative duration should be treated as absolute": {
+			val: ref.Add(-time.Minute),
+			d:   -time.Minute,
+		},
+		"date outside duration should fail": {
+			val:    ref.Add(time.Minute + time.Nanosecond),
+			d:      time.Minute,
+			expErr: fmt.Errorf(validateDateWithin, ref.Add(time.Minute+time.Nanosecond), time.Minute, ref),
+		},
+		"zero date should fail": {
+			val:    time.Time{},
+			d:      time.Minute,
+			expErr: fmt.Errorf(validateDateWithin, time.Time{}, time.Minute, ref),
+		},
+		"far future date should fail": {
+			val:    ref.AddDate(500, 0, 0),
+			d:      time.Minute,
+			expErr: fmt.Errorf(validateDateWithin, ref.AddDate(500, 0, 0), time.Minute, ref),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, WithinDuration(test.val, ref, test.d)())
+		})
+	}
+}
+
+func TestDateString(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		layout string
+		expErr error
+	}{
+		"valid RFC3339 date should pass": {
+			val:    "2000-10-12T07:20:50.52Z",
+			layout: time.RFC3339,
+		},
+		"invalid RFC3339 date should fail": {
+			val:    "2000-10-12 07:20:50",
+			layout: time.RFC3339,
+			expErr: fmt.Errorf(validateDateString, "2000-10-12 07:20:50", time.RFC3339),
+		},
+		"valid custom layout should pass": {
+			val:    "2021-02-28",
+			layout: "2006-01-02",
+		},
+		"out of range custom layout should fail": {
+			val:    "2021-02-30",
+			layout: "2006-01-02",
+			expErr: fmt.Errorf(validateDateString, "2021-02-30", "2006-01-02"),
+		},
+		"wrong order custom layout should fail": {
+			val:    "28-02-2021",
+			layout: "2006-01-02",
+			expErr: fmt.Errorf(validateDateString, "28-02-2021", "2006-01-02"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, DateString(test.val, test.layout)())
+		})
+	}
+}
+
+func TestCardExpiryAt(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	now := time.Date(2026, time.June, 30, 23, 59, 0, 0, time.UTC)
+	tt := map[string]struct {
+		month  int
+		year   int
+		expErr error
+	}{
+		"future expiry should pass": {
+			month: 1,
+			year:  2027,
+		},
+		"current month should pass": {
+			month: 6,
+			year:  2026,
+		},
+		"two digit year should pass": {
+			month: 9,
+			year:  29,
+		},
+		"past month should fail": {
+			month:  5,
+			year:   2026,
+			expErr: fmt.Errorf(validateCardExpired, 5, 2026),
+		},
+		"past year should fail": {
+			month:  12,
+			year:   2025,
+			expErr: fmt.Errorf(validateCardExpired, 12, 2025),
+		},
+		"past two digit year should fail": {
+			month:  12,
+			year:   25,
+			expErr: fmt.Errorf(validateCardExpired, 12, 2025),
+		},
+		"month zero should fail": {
+			month:  0,
+			year:   2027,
+			expErr: fmt.Errorf(validateCardExpiryMonth, 0),
+		},
+		"month 13 should fail": {
+			month:  13,
+			year:   2027,
+			expErr: fmt.Errorf(validateCardExpiryMonth, 13),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, CardExpiryAt(test.month, test.year, now)())
+		})
+	}
+}
+
+func TestTimezone(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"valid zone should pass": {
+			val: "Europe/London",
+		},
+		"multi part zone should pass": {
+			val: "America/Argentina/Buenos_Aires",
+		},
+		"UTC should pass": {
+			val: "UTC",
+		},
+		"Local should pass": {
+			val: "Local",
+		},
+		"misspelt zone should fail": {
+			val:    "Europe/Londn",
+			expErr: fmt.Errorf(validateTimezone, "Europe/Londn"),
+		},
+		"path traversal should fail": {
+			val:    "../etc/passwd",
+			expErr: fmt.Errorf(validateTimezone, "../etc/passwd"),
+		},
+		"empty string should fail": {
+			val:    "",
+			expErr: fmt.Errorf(validateTimezone, ""),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Timezone(test.val)())
+		})
+	}
+}
+
+func TestLanguageTag(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val   []string
+		valid bool
+	}{
+		"valid tags should pass": {
+			val: []string{
+				"en", "en-GB", "EN-gb", "zh-Hant", "zh-Hant-TW", "es-419", "sl-rozaj-biske",
+				"de-CH-1901", "zh-yue-HK", "en-US-u-ca-gregory", "en-x-private", "x-klingon",
+			},
+			valid: true,
+		},
+		"invalid tags should fail": {
+			val: []string{
+				"english", "e", "en_GB", "en-", "-en", "en--GB", "en-GB-", "en-a", "en-x",
+				"en-u", "toolongtag", "en-GB-123456789", "",
+			},
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			for _, v := range test.val {
+				err := LanguageTag(v)()
+				if test.valid {
+					is.NoErr(err)
+					continue
+				}
+				is.Equal(fmt.Errorf(validateLanguageTag, v), err)
+			}
+		})
+	}
+}
+
+func TestSameCalendarDay(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		times  []time.Time
+		expErr error
+	}{
+		"same day times should pass": {
+			times: []time.Time{
+				time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2021, 1, 1, 12, 30, 0, 0, time.UTC),
+				time.Date(2021, 1, 1, 23, 59, 59, 0, time.UTC),
+			},
+		},
+		"no times should pass": {},
+		"times spanning midnight should fail": {
+			times: []time.Time{
+				time.Date(2021, 1, 1, 23, 0, 0, 0, time.UTC),
+				time.Date(2021, 1, 1, 23, 59, 59, 0, time.UTC),
+				time.Date(2021, 1, 2, 0, 0, 1, 0, time.UTC),
+			},
+			expErr: fmt.Errorf(validateSameDay, 2),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, SameCalendarDay(test.times...)())
+		})
+	}
+}
+
+func TestIsWeekday(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    time.Time
+		expErr error
+	}{
+		"monday should pass": {
+			val: time.Date(2021, 1, 4, 9, 0, 0, 0, time.UTC),
+		},
+		"saturday should fail": {
+			val:    time.Date(2021, 1, 2, 9, 0, 0, 0, time.UTC),
+			expErr: fmt.Errorf(validateWeekday, time.Date(2021, 1, 2, 9, 0, 0, 0, time.UTC)),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, IsWeekday(test.val)())
+		})
+	}
+}
+
+func TestIsWeekend(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    time.Time
+		expErr error
+	}{
+		"saturday should pass": {
+			val: time.Date(2021, 1, 2, 9, 0, 0, 0, time.UTC),
+		},
+		"monday should fail": {
+			val:    time.Date(2021, 1, 4, 9, 0, 0, 0, time.UTC),
+			expErr: fmt.Errorf(validateWeekend, time.Date(2021, 1, 4, 9, 0, 0, 0, time.UTC)),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, IsWeekend(test.val)())
+		})
+	}
+}
+
+func TestIsWeekdayIn(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    time.Time
+		days   []time.Weekday
+		expErr error
+	}{
+		"saturday in allowed days should pass": {
+			val:  time.Date(2021, 1, 2, 9, 0, 0, 0, time.UTC),
+			days: []time.Weekday{time.Saturday, time.Monday},
+		},
+		"monday not in allowed days should fail": {
+			val:  time.Date(2021, 1, 4, 9, 0, 0, 0, time.UTC),
+			days: []time.Weekday{time.Tuesday, time.Saturday},
+			expErr: fmt.Errorf(validateWeekdayIn,
+				time.Date(2021, 1, 4, 9, 0, 0, 0, time.UTC),
+				[]time.Weekday{time.Tuesday, time.Saturday}),
+		},
+		"no allowed days should fail": {
+			val: time.Date(2021, 1, 4, 9, 0, 0, 0, time.UTC),
+			expErr: fmt.Errorf(validateWeekdayIn,
+				time.Date(2021, 1, 4, 9, 0, 0, 0, time.UTC),
+				[]time.Weekday(nil)),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, IsWeekdayIn(test.val, test.days...)())
+		})
+	}
+}
+
+func TestIsNumeric(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"valid number should pass": {
+			val: "12345",
+		},
+		"valid negative number should pass": {
+			val: "-12345",
+		},
+		"invalid number should fail": {
+			val:    "12345a",
+			expErr: fmt.Errorf(validateIsNumeric, "12345a"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, IsNumeric(test.val)())
+		})
+	}
+}
+
+func TestIsNumericBetween(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val      string
+		min, max int
+		expErr   error
+	}{
+		"in range should pass": {
+			val: "5",
+			min: 1,
+			max: 10,
+		},
+		"boundaries should pass": {
+			val: "-10",
+			min: -10,
+			max: 10,
+		},
+		"below range should fail": {
+			val:    "0",
+			min:    1,
+			max:    10,
+			expErr: fmt.Errorf(validateNumBetween, 0, 1, 10),
+		},
+		"above range should fail": {
+			val:    "11",
+			min:    1,
+			max:    10,
+			expErr: fmt.Errorf(validateNumBetween, 11, 1, 10),
+		},
+		"non numeric should fail": {
+			val:    "ten",
+			min:    1,
+			max:    10,
+			expErr: fmt.Errorf(validateIsNumeric, "ten"),
+		},
+		"decimal should fail": {
+			val:    "1.5",
+			min:    1,
+			max:    10,
+			expErr: fmt.Errorf(validateIsNumeric, "1.5"),
+		},
+		"overflow should fail": {
+			val:    "99999999999999999999",
+			min:    1,
+			max:    10,
+			expErr: fmt.Errorf(validateNumericOverflow, "99999999999999999999"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, IsNumericBetween(test.val, test.min, test.max)())
+		})
+	}
+}
+
+func TestNumericLength(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"correct length should pass": {
+			val: "123456789",
+		},
+		"leading zeros should pass": {
+			val: "000123456",
+		},
+		"too short should fail": {
+			val:    "12345678",
+			expErr: fmt.Errorf(validateNumericLength, 9),
+		},
+		"too long should fail": {
+			val:    "1234567890",
+			expErr: fmt.Errorf(validateNumericLength, 9),
+		},
+		"non numeric should fail": {
+			val:    "12345678a",
+			expErr: fmt.Errorf(validateNumericLength, 9),
+		},
+		"signed should fail": {
+			val:    "-12345678",
+			expErr: fmt.Errorf(validateNumericLength, 9),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, NumericLength(test.val, 9)())
+		})
+	}
+}
+
+func TestParseUint(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val     string
+		bitSize int
+		expErr  error
+	}{
+		"valid uint64 should pass": {
+			val:     "18446744073709551615",
+			bitSize: 64,
+		},
+		"valid uint8 should pass": {
+			val:     "255",
+			bitSize: 8,
+		},
+		"zero should pass": {
+			val:     "0",
+			bitSize: 0,
+		},
+		"negative should fail": {
+			val:     "-1",
+			bitSize: 64,
+			expErr:  fmt.Errorf(validateParseUint, "-1"),
+		},
+		"plus sign should fail": {
+			val:     "+1",
+			bitSize: 64,
+			expErr:  fmt.Errorf(validateParseUint, "+1"),
+		},
+		"non numeric should fail": {
+			val:     "abc",
+			bitSize: 64,
+			expErr:  fmt.Errorf(validateParseUint, "abc"),
+		},
+		"uint64 overflow should fail": {
+			val:     "99999999999999999999",
+			bitSize: 64,
+			expErr:  fmt.Errorf(validateUintOverflow, "99999999999999999999", 64),
+		},
+		"uint8 overflow should fail": {
+			val:     "256",
+			bitSize: 8,
+			expErr:  fmt.Errorf(validateUintOverflow, "256", 8),
+		},
+		"uint overflow should report the platform size": {
+			val:     "99999999999999999999",
+			bitSize: 0,
+			expErr:  fmt.Errorf(validateUintOverflow, "99999999999999999999", strconv.IntSize),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, ParseUint(test.val, test.bitSize)())
+		})
+	}
+}
+
+func TestIsFloat(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"integer should pass": {
+			val: "42",
+		},
+		"decimal should pass": {
+			val: "-10.25",
+		},
+		"scientific notation should pass": {
+			val: "1.5e3",
+		},
+		"multiple decimal points should fail": {
+			val:    "1.2.3",
+			expErr: fmt.Errorf(validateIsFloat, "1.2.3"),
+		},
+		"letters should fail": {
+			val:    "12abc",
+			expErr: fmt.Errorf(validateIsFloat, "12abc"),
+		},
+		"empty string should fail": {
+			val:    "",
+			expErr: fmt.Errorf(validateIsFloat, ""),
+		},
+		"NaN should fail": {
+			val:    "NaN",
+			expErr: fmt.Errorf(validateIsFloat, "NaN"),
+		},
+		"infinity should fail": {
+			val:    "-Inf",
+			expErr: fmt.Errorf(validateIsFloat, "-Inf"),
+		},
+		"out of range should fail": {
+			val:    "1e400",
+			expErr: fmt.Errorf(validateIsFloat, "1e400"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, IsFloat(test.val)())
+		})
+	}
+}
+
+func TestIsBool(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val   []string
+		valid bool
+	}{
+		"accepted forms should pass": {
+			val:   []string{"1", "t", "T", "TRUE", "true", "True", "0", "f", "F", "FALSE", "false", "False"},
+			valid: true,
+		},
+		"rejected forms should fail": {
+			val: []string{"yes", "no", "on", "off", "tRuE", "2", ""},
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			for _, v := range test.val {
+				err := IsBool(v)()
+				if test.valid {
+					is.NoErr(err)
+					continue
+				}
+				is.Equal(fmt.Errorf(validateIsBool, v), err)
+			}
+		})
+	}
+}
+
+func TestUKPostCode(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    []string
+		expErr error
+	}{
+		"Valid postcodes should pass": {
+			val: []string{"bt13 4GH", "NW1A 1AA", "A9A 9AA", "A9 9AA", "A99 9AA"},
+		},
+		"Invalid postcodes should fail": {
+			val:    []string{"GGG 7GH", "NW1A 1A", "N1 GF", "N11 DDD"},
+			expErr: fmt.Errorf(validateUkPostCode, "GGG 7GH"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			for _, p := range test.val {
+				err := UKPostCode(p)()
+				if test.expErr == nil {
+					is.NoErr(err)
+					continue
+				}
+				is.Equal(err != nil, true)
+			}
+		})
+	}
+}
+
+func TestZipCode(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    []string
+		expErr error
+	}{
+		"Valid zipcodes should pass": {
+			val: []string{"57501", "17101", "12201-7050", "99750-0077"},
+		},
+		"Invalid zipcodes should fail": {
+			val:    []string{"GGG 7GH", "99750-00", "99750-0", "99750-", "1111"},
+			expErr: fmt.Errorf(validateUkPostCode, "GGG 7GH"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			for _, p := range test.val {
+				err := USZipCode(p)()
+				if test.expErr == nil {
+					is.NoErr(err)
+					continue
+				}
+				is.Equal(err != nil, true)
+			}
+		})
+	}
+}
+
+func TestCAPostalCode(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val   []string
+		valid bool
+	}{
+		"Valid postal codes should pass": {
+			val:   []string{"K1A 0B1", "M5V3L9", "h0h 0h0", "T2X 1V4"},
+			valid: true,
+		},
+		"Invalid postal codes should fail": {
+			val: []string{
+				"D1A 0B1",  // D not used.
+				"K1O 0B1",  // O not used.
+				"W1A 0B1",  // W not used as first letter.
+				"Z1A 0B1",  // Z not used as first letter.
+				"K1A  0B1", // double space.
+				"K1A-0B1",  // wrong separator.
+				"KK1 0B1",  // letter digit order.
+				"K1A 0B",   // too short.
+				"90210",    // zip code.
+				"SW1A 1AA", // UK postcode.
+				"K1A 0B1 ", // trailing space.
+			},
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			for _, p := range test.val {
+				err := CAPostalCode(p)()
+				if test.valid {
+					is.NoErr(err)
+					continue
+				}
+				is.Equal(fmt.Errorf(validateCAPostalCode, p), err)
+			}
+		})
+	}
+}
+
+func TestSSN(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val   []string
+		valid bool
+	}{
+		"valid numbers should pass": {
+			val:   []string{"123-45-6789", "123456789", "899-01-0001", "665-99-9999"},
+			valid: true,
+		},
+		"invalid numbers should fail": {
+			val: []string{
+				"000-45-6789", // area 000.
+				"666-45-6789", // area 666.
+				"900-45-6789", // area 900-999.
+				"999-45-6789", // area 900-999.
+				"123-00-6789", // group 00.
+				"123-45-0000", // serial 0000.
+				"12345-6789",  // partial hyphens.
+				"123-456-789", // wrong grouping.
+				"123-45-678",  // too short.
+				"abc-de-fghi", // not digits.
+				"",
+			},
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			for _, v := range test.val {
+				err := SSN(v)()
+				if test.valid {
+					is.NoErr(err)
+					continue
+				}
+				is.Equal(errors.New(validateSSN), err)
+			}
+		})
+	}
+}
+
+func TestIMEI(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"valid IMEI should pass": {
+			val: "490154203237518",
+		},
+		"another valid IMEI should pass": {
+			val: "356938035643809",
+		},
+		"wrong check digit should fail": {
+			val:    "490154203237519",
+			expErr: errors.New(validateIMEI),
+		},
+		"transposed digits should fail": {
+			val:    "490154203273518",
+			expErr: errors.New(validateIMEI),
+		},
+		"14 digits should fail": {
+			val:    "49015420323751",
+			expErr: errors.New(validateIMEI),
+		},
+		"separators should fail": {
+			val:    "49-015420-323751-8",
+			expErr: errors.New(validateIMEI),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, IMEI(test.val)())
+		})
+	}
+}
+
+func TestLuhn(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"valid checksum should pass": {
+			val: "79927398713",
+		},
+		"valid card number should pass": {
+			val: "4111111111111111",
+		},
+		"single zero should pass": {
+			val: "0",
+		},
+		"invalid checksum should fail": {
+			val:    "79927398710",
+			expErr: errors.New(validateLuhn),
+		},
+		"non digit should fail": {
+			val:    "7992-7398-713",
+			expErr: errors.New(validateLuhn),
+		},
+		"empty string should fail": {
+			val:    "",
+			expErr: errors.New(validateLuhn),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Luhn(test.val)())
+		})
+	}
+}
+
+func TestPhoneExtension(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"4 digit extension should pass": {
+			val: "1234",
+		},
+		"6 digit extension should pass": {
+			val: "123456",
+		},
+		"all zero extension should pass": {
+			val: "000",
+		},
+		"2 digit extension should fail": {
+			val:    "12",
+			expErr: fmt.Errorf(validateExtension, "12"),
+		},
+		"7 digit extension should fail": {
+			val:    "1234567",
+			expErr: fmt.Errorf(validateExtension, "1234567"),
+		},
+		"leading zero should fail": {
+			val:    "0123",
+			expErr: fmt.Errorf(validateExtension, "0123"),
+		},
+		"non digit should fail": {
+			val:    "12a3",
+			expErr: fmt.Errorf(validateExtension, "12a3"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, PhoneExtension(test.val)())
+		})
+	}
+}
+
+func TestMACOUI(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"colon separated oui should pass": {
+			val: "00:1A:2B",
+		},
+		"hyphen separated oui should pass": {
+			val: "00-1A-2B",
+		},
+		"lower case oui should pass": {
+			val: "00:1a:2b",
+		},
+		"full mac address should fail": {
+			val:    "00:1A:2B:3C:4D:5E",
+			expErr: fmt.Errorf(validateMACOUI, "00:1A:2B:3C:4D:5E"),
+		},
+		"non hex octet should fail": {
+			val:    "00:1G:2B",
+			expErr: fmt.Errorf(validateMACOUI, "00:1G:2B"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, MACOUI(test.val)())
+		})
+	}
+}
+
+func TestEthereumAddress(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"checksummed addresses should pass": {
+			val: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		},
+		"checksummed address with leading letters should pass": {
+			val: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		},
+		"lower case address should pass": {
+			val: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		},
+		"upper case address should pass": {
+			val: "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED",
+		},
+		"corrupted checksum should fail": {
+			val:    "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD",
+			expErr: fmt.Errorf(validateEthereumChecksum, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD"),
+		},
+		"missing prefix should fail": {
+			val:    "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			expErr: fmt.Errorf(validateEthereumAddress, "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+		},
+		"short address should fail": {
+			val:    "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA",
+			expErr: fmt.Errorf(validateEthereumAddress, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA"),
+		},
+		"non hex character should fail": {
+			val:    "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg",
+			expErr: fmt.Errorf(validateEthereumAddress, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, EthereumAddress(test.val)())
+		})
+	}
+}
+
+func TestFlagKey(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val      string
+		maxDepth int
+		expErr   error
+	}{
+		"valid key should pass": {
+			val:      "ui.dark_mode",
+			maxDepth: 3,
+		},
+		"key at max depth should pass": {
+			val:      "a.b.c",
+			maxDepth: 3,
+		},
+		"empty segment should fail": {
+			val:      "ui..dark_mode",
+			maxDepth: 3,
+			expErr:   fmt.Errorf(validateFlagKey, "ui..dark_mode"),
+		},
+		"empty key should fail": {
+			val:      "",
+			maxDepth: 3,
+			expErr:   fmt.Errorf(validateFlagKey, ""),
+		},
+		"disallowed character should fail": {
+			val:      "ui.dark-mode",
+			maxDepth: 3,
+			expErr:   fmt.Errorf(validateFlagKey, "ui.dark-mode"),
+		},
+		"upper case character should fail": {
+			val:      "ui.Dark",
+			maxDepth: 3,
+			expErr:   fmt.Errorf(validateFlagKey, "ui.Dark"),
+		},
+		"key exceeding max depth should fail": {
+			val:      "a.b.c.d",
+			maxDepth: 3,
+			expErr:   fmt.Errorf(validateFlagKey, "a.b.c.d"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, FlagKey(test.val, test.maxDepth)())
+		})
+	}
+}
+
+func TestDomain(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	long := strings.Repeat(strings.Repeat("a", 63)+".", 4) + "com"
+	tt := map[string]struct {
+		val   []string
+		valid bool
+	}{
+		"valid domains should pass": {
+			val:   []string{"example.com", "sub.example.co.uk", "EXAMPLE.COM", "xn--bcher-kva.example", "a-b.io"},
+			valid: true,
+		},
+		"invalid domains should fail": {
+			val: []string{
+				"localhost", "example", "example.com.", ".example.com", "example..com",
+				"-example.com", "example-.com", "example.c", "example.c0m", "exa mple.com",
+				"example_1.com", strings.Repeat("a", 64) + ".com", long, "",
+			},
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			for _, v := range test.val {
+				err := Domain(v)()
+				if test.valid {
+					is.NoErr(err)
+					continue
+				}
+				is.Equal(fmt.Errorf(validateDomain, v), err)
+			}
+		})
+	}
+}
+
+func TestDataURI(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"base64 png should pass": {
+			val: "data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==",
+		},
+		"plain text with parameters should pass": {
+			val: "data:text/plain;charset=utf-8,hello%20world",
+		},
+		"empty media type should pass": {
+			val: "data:,hello",
+		},
+		"missing scheme should fail": {
+			val:    "image/png;base64,iVBORw0KGgo=",
+			expErr: errors.New(validateDataURI),
+		},
+		"missing comma should fail": {
+			val:    "data:image/png;base64",
+			expErr: errors.New(validateDataURI),
+		},
+		"invalid base64 payload should fail": {
+			val:    "data:image/png;base64,iVBORw0KGgo!!",
+			expErr: errors.New(validateDataURI),
+		},
+		"invalid media type should fail": {
+			val:    "data:image;base64,iVBORw0KGgo=",
+			expErr: errors.New(validateDataURI),
+		},
+		"invalid url encoding should fail": {
+			val:    "data:text/plain,100%zz",
+			expErr: errors.New(validateDataURI),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, DataURI(test.val)())
+		})
+	}
+}
+
+func TestMIMEType(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"simple type should pass": {
+			val: "application/json",
+		},
+		"parameterised type should pass": {
+			val: "text/plain; charset=utf-8",
+		},
+		"vendor type should pass": {
+			val: "application/vnd.api+json",
+		},
+		"missing subtype should fail": {
+			val:    "text",
+			expErr: fmt.Errorf(validateMIMEType, "text"),
+		},
+		"empty subtype should fail": {
+			val:    "text/",
+			expErr: fmt.Errorf(validateMIMEType, "text/"),
+		},
+		"malformed parameter should fail": {
+			val:    "text/plain; charset",
+			expErr: fmt.Errorf(validateMIMEType, "text/plain; charset"),
+		},
+		"empty string should fail": {
+			val:    "",
+			expErr: fmt.Errorf(validateMIMEType, ""),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, MIMEType(test.val)())
+		})
+	}
+}
+
+func TestMIMETypeIn(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val     string
+		allowed []string
+		expErr  error
+	}{
+		"allowed type should pass": {
+			val:     "application/json",
+			allowed: []string{"application/json", "text/plain"},
+		},
+		"parameters and case should be ignored": {
+			val:     "Text/Plain; charset=utf-8",
+			allowed: []string{"application/json", "text/plain"},
+		},
+		"type not allowed should fail": {
+			val:     "image/png",
+			allowed: []string{"application/json", "text/plain"},
+			expErr:  fmt.Errorf(validateMIMETypeIn, "image/png", "application/json, text/plain"),
+		},
+		"malformed type should fail": {
+			val:     "json",
+			allowed: []string{"application/json"},
+			expErr:  fmt.Errorf(validateMIMEType, "json"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, MIMETypeIn(test.val, test.allowed...)())
+		})
+	}
+}
+
+func TestEmail(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"email without a domain should fail": {
+			val:    "test@",
+			expErr: fmt.Errorf(validateEmail),
+		},
+		"email without a prefix": {
+			val:    "@test.com",
+			expErr: fmt.Errorf(validateEmail),
+		},
+		"emails are not required to have a tld so will pass": {
+			val: "test@mail",
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Email(test.val)())
+		})
+	}
+}
+
+func TestEmailStrict(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"email with a tld should pass": {
+			val: "test@mail.com",
+		},
+		"named address should pass": {
+			val: "Test <test@mail.co.uk>",
+		},
+		"email without a tld should fail": {
+			val:    "test@mail",
+			expErr: errors.New(validateEmail),
+		},
+		"email with a numeric tld should fail": {
+			val:    "test@mail.123",
+			expErr: errors.New(validateEmail),
+		},
+		"email without a domain should fail": {
+			val:    "test@",
+			expErr: errors.New(validateEmail),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, EmailStrict(test.val)())
+		})
+	}
+	// the lenient validator is unchanged.
+	is.NoErr(Email("test@mail")())
+}
+
+func TestEmailWithDomains(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"allowed domain should pass": {
+			val: "jane@corp.com",
+		},
+		"allowed domain in a different case should pass": {
+			val: "jane@Corp.COM",
+		},
+		"named address should pass": {
+			val: "Jane <jane@corp.io>",
+		},
+		"other domain should fail": {
+			val:    "jane@gmail.com",
+			expErr: fmt.Errorf(validateEmailDomain, "gmail.com"),
+		},
+		"subdomain should fail": {
+			val:    "jane@mail.corp.com",
+			expErr: fmt.Errorf(validateEmailDomain, "mail.corp.com"),
+		},
+		"malformed address should fail": {
+			val:    "jane@",
+			expErr: errors.New(validateEmail),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, EmailWithDomains(test.val, "corp.com", "corp.io")())
+		})
+	}
+}
+
+func TestEmailExcludingDomains(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"other domain should pass": {
+			val: "jane@corp.com",
+		},
+		"blocked domain should fail": {
+			val:    "jane@mailinator.com",
+			expErr: fmt.Errorf(validateEmailDomainBlocked, "mailinator.com"),
+		},
+		"blocked domain in a different case should fail": {
+			val:    "jane@MailDrop.cc",
+			expErr: fmt.Errorf(validateEmailDomainBlocked, "MailDrop.cc"),
+		},
+		"malformed address should fail": {
+			val:    "@mailinator.com",
+			expErr: errors.New(validateEmail),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, EmailExcludingDomains(test.val, "mailinator.com", "maildrop.cc")())
+		})
+	}
+}
+
+func TestNotEmpty(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    interface{}
+		expErr error
+	}{
+		"nil ptr": {
+			val:    nil,
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty string": {
+			val: "hello",
+		},
+		"empty string": {
+			val:    "",
+			expErr: errors.New(validateEmpty),
+		},
+		"whitespace string": {
+			val: "   ",
+		},
+		"non-empty time": {
+			val: time.Now(),
+		},
+		"empty time": {
+			val:    time.Time{},
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty int": {
+			val: 235,
+		},
+		"empty int": {
+			val:    0,
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty int8": {
+			val: int8(5),
+		},
+		"empty int8": {
+			val:    int8(0),
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty int16": {
+			val: int16(5),
+		},
+		"empty int16": {
+			val:    int16(0),
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty int32": {
+			val: int32(5),
+		},
+		"empty int32": {
+			val:    int32(0),
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty int64": {
+			val: int64(5),
+		},
+		"empty int64": {
+			val:    int64(0),
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty uint": {
+			val: 235,
+		},
+		"empty uint": {
+			val:    0,
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty uint8": {
+			val: uint8(5),
+		},
+		"empty uint8": {
+			val:    uint8(0),
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty uint16": {
+			val: uint16(5),
+		},
+		"empty uint16": {
+			val:    uint16(0),
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty uint32": {
+			val: uint32(5),
+		},
+		"empty uint32": {
+			val:    uint32(0),
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty uint64": {
+			val: uint64(5),
+		},
+		"empty uint64": {
+			val:    uint64(0),
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty float32": {
+			val: float32(5),
+		},
+		"empty float32": {
+			val:    float32(0),
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty float64": {
+			val: float64(5),
+		},
+		"empty float64": {
+			val:    float64(0),
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty array": {
+			val: [2]string{"hello", "there"},
+		},
+		"empty array": {
+			val:    [2]string{"", ""},
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty slice": {
+			val: []string{"hello", "there"},
+		},
+		"empty slice": {
+			val:    []string{},
+			expErr: errors.New(validateEmpty),
+		},
+		"non-empty map": {
+			val: map[string]string{"hello": "there"},
+		},
+		"empty map": {
+			val:    map[string]string{},
+			expErr: errors.New(validateEmpty),
+		},
+		"nil string pointer": {
+			val:    (*string)(nil),
+			expErr: errors.New(validateEmpty),
+		},
+		"pointer to empty string": {
+			val:    strPtr(""),
+			expErr: errors.New(validateEmpty),
+		},
+		"pointer to non-empty string": {
+			val: strPtr("x"),
+		},
+		"pointer to pointer to empty string": {
+			val:    func() **string { s := strPtr(""); return &s }(),
+			expErr: errors.New(validateEmpty),
+		},
+		"pointer to empty slice": {
+			val:    &[]string{},
+			expErr: errors.New(validateEmpty),
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, NotEmpty(test.val)())
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestNotEmptyT(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	type point struct {
+		X, Y int
+	}
+	is.NoErr(NotEmptyT("hello")())
+	is.Equal(errors.New(validateEmpty), NotEmptyT("")())
+	is.NoErr(NotEmptyT(235)())
+	is.Equal(errors.New(validateEmpty), NotEmptyT(0)())
+	is.NoErr(NotEmptyT(point{X: 1})())
+	is.Equal(errors.New(validateEmpty), NotEmptyT(point{})())
+	is.NoErr(NotEmptyT(time.Now())())
+	is.Equal(errors.New(validateEmpty), NotEmptyT(time.Time{})())
+}
+
+func TestSliceNotEmpty(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    []string
+		expErr error
+	}{
+		"nil slice should fail": {
+			expErr: errors.New(validateEmpty),
+		},
+		"empty slice should fail": {
+			val:    []string{},
+			expErr: errors.New(validateEmpty),
+		},
+		"populated slice should pass": {
+			val: []string{"a"},
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, SliceNotEmpty(test.val)())
+		})
+	}
+}
+
+func TestSliceEmpty(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    []int
+		expErr error
+	}{
+		"nil slice should pass": {},
+		"empty slice should pass": {
+			val: []int{},
+		},
+		"populated slice should fail": {
+			val:    []int{1, 2},
+			expErr: errors.New(validateNotEmpty),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, SliceEmpty(test.val)())
+		})
+	}
+}
+
+func BenchmarkNotEmpty(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = NotEmpty("hello")()
+	}
+}
+
+func BenchmarkNotEmptyT(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = NotEmptyT("hello")()
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    interface{}
+		expErr error
+	}{
+		"nil ptr": {
+			val: nil,
+		},
+		"non-empty string": {
+			val:    "hello",
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty string": {
+			val: "",
+		},
+		"non-empty time": {
+			val:    time.Now(),
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty time": {
+			val: time.Time{},
+		},
+		"non-empty int": {
+			val:    235,
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty int": {
+			val: 0,
+		},
+		"non-empty int8": {
+			val:    int8(5),
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty int8": {
+			val: int8(0),
+		},
+		"non-empty int16": {
+			val:    int16(5),
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty int16": {
+			val: int16(0),
+		},
+		"non-empty int32": {
+			val:    int32(5),
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty int32": {
+			val: int32(0),
+		},
+		"non-empty int64": {
+			val:    int64(5),
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty int64": {
+			val: int64(0),
+		},
+		"non-empty uint": {
+			val:    235,
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty uint": {
+			val: 0,
+		},
+		"non-empty uint8": {
+			val:    uint8(5),
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty uint8": {
+			val: uint8(0),
+		},
+		"non-empty uint16": {
+			val:    uint16(5),
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty uint16": {
+			val: uint16(0),
+		},
+		"non-empty uint32": {
+			val:    uint32(5),
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty uint32": {
+			val: uint32(0),
+		},
+		"non-empty uint64": {
+			val:    uint64(5),
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty uint64": {
+			val: uint64(0),
+		},
+		"non-empty float32": {
+			val:    float32(5),
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty float32": {
+			val: float32(0),
+		},
+		"non-empty float64": {
+			val:    float64(5),
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty float64": {
+			val: float64(0),
+		},
+		"non-empty array": {
+			val:    [2]string{"hello", "there"},
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty array": {
+			val: [2]string{"", ""},
+		},
+		"non-empty slice": {
+			val:    []string{"hello", "there"},
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty slice": {
+			val: []string{},
+		},
+		"non-empty map": {
+			val:    map[string]string{"hello": "there"},
+			expErr: errors.New(validateNotEmpty),
+		},
+		"empty map": {
+			val: map[string]string{},
+		},
+		"nil string pointer": {
+			val: (*string)(nil),
+		},
+		"pointer to empty string": {
+			val: strPtr(""),
+		},
+		"pointer to non-empty string": {
+			val:    strPtr("x"),
+			expErr: errors.New(validateNotEmpty),
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Empty(test.val)())
+		})
+	}
+}
+
+func TestBlank(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"empty string should pass": {
+			val: "",
+		},
+		"whitespace string should pass": {
+			val: "  \t\n ",
+		},
+		"non-empty string should fail": {
+			val:    "x",
+			expErr: errors.New(validateBlank),
+		},
+		"padded string should fail": {
+			val:    "  x  ",
+			expErr: errors.New(validateBlank),
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Blank(test.val)())
+		})
+	}
+}
+
+func TestTrimmed(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"clean string should pass": {
+			val: "hello world",
+		},
+		"empty string should pass": {
+			val: "",
+		},
+		"leading space should fail": {
+			val:    " hello",
+			expErr: errors.New(validateTrimmed),
+		},
+		"trailing newline should fail": {
+			val:    "hello\n",
+			expErr: errors.New(validateTrimmed),
+		},
+		"leading tab should fail": {
+			val:    "\thello",
+			expErr: errors.New(validateTrimmed),
+		},
+		"whitespace only should fail": {
+			val:    "   ",
+			expErr: errors.New(validateTrimmed),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Trimmed(test.val)())
+		})
+	}
+}
+
+func TestContainsDigit(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"string with digit should pass": {
+			val: "passw0rd",
+		},
+		"string without digit should fail": {
+			val:    "password",
+			expErr: errors.New(validateContainsDigit),
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, ContainsDigit(test.val)())
+		})
+	}
+}
+
+func TestContainsUpper(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"string with upper case should pass": {
+			val: "passWord",
+		},
+		"string with unicode upper case should pass": {
+			val: "Émile",
+		},
+		"string without upper case should fail": {
+			val:    "password1",
+			expErr: errors.New(validateContainsUpper),
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, ContainsUpper(test.val)())
+		})
+	}
+}
+
+func TestContainsLower(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"string with lower case should pass": {
+			val: "PASSWoRD",
+		},
+		"string without lower case should fail": {
+			val:    "PASSWORD1",
+			expErr: errors.New(validateContainsLower),
+		},
+		"empty string should fail": {
+			val:    "",
+			expErr: errors.New(validateContainsLower),
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, ContainsLower(test.val)())
+		})
+	}
+}
+
+func TestIsASCII(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"plain identifier should pass": {
+			val: "order_ref-123",
+		},
+		"empty string should pass": {
+			val: "",
+		},
+		"tab should pass": {
+			val: "a\tb",
+		},
+		"newline should pass": {
+			val: "a\nb",
+		},
+		"unicode character should fail": {
+			val:    "café",
+			expErr: errors.New(validateASCII),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, IsASCII(test.val)())
+		})
+	}
+}
+
+func TestIsPrintableASCII(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"plain identifier should pass": {
+			val: "order ref-123 ~!",
+		},
+		"empty string should pass": {
+			val: "",
+		},
+		"tab should fail": {
+			val:    "a\tb",
+			expErr: errors.New(validatePrintableASCII),
+		},
+		"newline should fail": {
+			val:    "a\nb",
+			expErr: errors.New(validatePrintableASCII),
+		},
+		"delete character should fail": {
+			val:    "a\x7fb",
+			expErr: errors.New(validatePrintableASCII),
+		},
+		"unicode character should fail": {
+			val:    "café",
+			expErr: errors.New(validatePrintableASCII),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, IsPrintableASCII(test.val)())
+		})
+	}
+}
+
+func TestNoControlChars(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"unicode name should pass": {
+			val: "Zoë Ångström 🚀",
+		},
+		"null character should fail": {
+			val:    "admin\u0000",
+			expErr: errors.New(validateNoControlChars),
+		},
+		"newline should fail": {
+			val:    "line1\nline2",
+			expErr: errors.New(validateNoControlChars),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, NoControlChars(test.val)())
+		})
+	}
+}
+
+func TestAny(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		list   []string
+		expErr error
+	}{
+		"matching item": {
+			val:  "hello",
+			list: []string{"wow", "hello", "ohwow"},
+		},
+		"missing item": {
+			val:    "hello",
+			list:   []string{"wow", "goodbye", "ohwow"},
+			expErr: errors.New("value not found in allowed values"),
+		},
+		"empty string found": {
+			val:  "",
+			list: []string{"wow", "", "ohwow"},
+		},
+		"empty string not found": {
+			val:    "",
+			list:   []string{"wow", "ohwow"},
+			expErr: errors.New("value not found in allowed values"),
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Any(test.val, test.list...)())
+		})
+	}
+}
+
+func TestAnyInt(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    int
+		list   []int
+		expErr error
+	}{
+		"matching item": {
+			val:  200,
+			list: []int{200, 201, 204},
+		},
+		"missing item": {
+			val:    500,
+			list:   []int{200, 201, 204},
+			expErr: errors.New(validateAny),
+		},
+		"empty allowed list should fail": {
+			val:    200,
+			expErr: errors.New(validateAny),
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Any(test.val, test.list...)())
+		})
+	}
+}
+
+type testStatus string
+
+func TestAnyCustomType(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    testStatus
+		list   []testStatus
+		expErr error
+	}{
+		"matching item": {
+			val:  "active",
+			list: []testStatus{"active", "inactive"},
+		},
+		"missing item": {
+			val:    "deleted",
+			list:   []testStatus{"active", "inactive"},
+			expErr: errors.New(validateAny),
+		},
+		"empty allowed list should fail": {
+			val:    "",
+			expErr: errors.New(validateAny),
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Any(test.val, test.list...)())
+		})
+	}
+}
+
+func TestRequiredKeys(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		m      map[string]interface{}
+		keys   []string
+		expErr error
+	}{
+		"all keys present should pass": {
+			m:    map[string]interface{}{"id": 1.0, "name": "bob"},
+			keys: []string{"id", "name"},
+		},
+		"null valued key should pass": {
+			m:    map[string]interface{}{"id": 1.0, "name": nil},
+			keys: []string{"id", "name"},
+		},
+		"single missing key should fail": {
+			m:      map[string]interface{}{"id": 1.0},
+			keys:   []string{"id", "name"},
+			expErr: fmt.Errorf(validateRequiredKeys, "name"),
+		},
+		"all missing keys should be listed": {
+			m:      map[string]interface{}{"id": 1.0},
+			keys:   []string{"name", "id", "email"},
+			expErr: fmt.Errorf(validateRequiredKeys, "name, email"),
+		},
+		"nil map should fail": {
+			keys:   []string{"id"},
+			expErr: fmt.Errorf(validateRequiredKeys, "id"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, RequiredKeys(test.m, test.keys...)())
+		})
+	}
+}
+
+func TestAnyString(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	is.NoErr(AnyString("b", "a", "b")())
+	is.Equal(errors.New(validateAny), AnyString("c", "a", "b")())
+	is.Equal(errors.New(validateAny), AnyString("c")())
+}
+
+func TestIn(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		fn     ValidationFunc
+		expErr error
+	}{
+		"allowed string should pass": {
+			fn: In("GBP", "GBP", "USD"),
+		},
+		"allowed int should pass": {
+			fn: In(2, 1, 2, 3),
+		},
+		"string not allowed should fail": {
+			fn:     In("EUR", "GBP", "USD"),
+			expErr: fmt.Errorf(validateIn, "EUR", []string{"GBP", "USD"}),
+		},
+		"int not allowed should fail": {
+			fn:     In(4, 1, 2, 3),
+			expErr: fmt.Errorf(validateIn, 4, []int{1, 2, 3}),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, test.fn())
+		})
+	}
+	is.Equal("value EUR must be one of [GBP USD]", In("EUR", "GBP", "USD")().Error())
+	is.Equal("value 4 must be one of [1 2 3]", In(4, 1, 2, 3)().Error())
+}
+
+func TestOneOf(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	type role string
+	const (
+		roleAdmin role = "admin"
+		roleUser  role = "user"
+	)
+	tt := map[string]struct {
+		val     role
+		allowed []role
+		expErr  error
+	}{
+		"allowed value should pass": {
+			val:     roleUser,
+			allowed: []role{roleAdmin, roleUser},
+		},
+		"untyped constant should pass": {
+			val:     "admin",
+			allowed: []role{roleAdmin, roleUser},
+		},
+		"unknown value should fail": {
+			val:     "guest",
+			allowed: []role{roleAdmin, roleUser},
+			expErr:  fmt.Errorf(validateIn, role("guest"), []role{roleAdmin, roleUser}),
+		},
+		"case should not be ignored": {
+			val:     "Admin",
+			allowed: []role{roleAdmin, roleUser},
+			expErr:  fmt.Errorf(validateIn, role("Admin"), []role{roleAdmin, roleUser}),
+		},
+		"empty allowed list should fail": {
+			val:    roleAdmin,
+			expErr: fmt.Errorf(validateIn, roleAdmin, []role(nil)),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, OneOf(test.val, test.allowed...)())
+		})
+	}
+}
+
+func TestAnyStringFold(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		list   []string
+		expErr error
+	}{
+		"exact match should pass": {
+			val:  "usd",
+			list: []string{"gbp", "usd"},
+		},
+		"mixed case match should pass": {
+			val:  "UsD",
+			list: []string{"gbp", "usd"},
+		},
+		"upper case allowed value should match": {
+			val:  "gbp",
+			list: []string{"GBP", "USD"},
+		},
+		"genuine miss should fail": {
+			val:    "EUR",
+			list:   []string{"gbp", "usd"},
+			expErr: errors.New(validateAny),
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, AnyStringFold(test.val, test.list...)())
+		})
+	}
+}
+
+func TestSliceContainsString(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    []string
+		want   string
+		expErr error
+	}{
+		"present item should pass": {
+			val:  []string{"user", "admin"},
+			want: "admin",
+		},
+		"absent item should fail": {
+			val:    []string{"user", "editor"},
+			want:   "admin",
+			expErr: fmt.Errorf(validateSliceContains, "admin"),
+		},
+		"nil slice should fail": {
+			want:   "admin",
+			expErr: fmt.Errorf(validateSliceContains, "admin"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, SliceContains(test.val, test.want)())
+		})
+	}
+}
+
+func TestSliceContainsInt(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    []int
+		want   int
+		expErr error
+	}{
+		"present item should pass": {
+			val:  []int{1, 2, 3},
+			want: 3,
+		},
+		"absent item should fail": {
+			val:    []int{1, 2, 3},
+			want:   4,
+			expErr: fmt.Errorf(validateSliceContains, 4),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, SliceContains(test.val, test.want)())
+		})
+	}
+}
+
+func TestContainsAll(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	required := []string{"read", "write"}
+	tt := map[string]struct {
+		val    []string
+		expErr error
+	}{
+		"complete slice should pass": {
+			val: []string{"admin", "write", "read"},
+		},
+		"partial slice should fail": {
+			val:    []string{"read"},
+			expErr: fmt.Errorf(validateContainsAll, []string{"write"}),
+		},
+		"empty slice should fail": {
+			val:    []string{},
+			expErr: fmt.Errorf(validateContainsAll, []string{"read", "write"}),
+		},
+		"nil slice should fail": {
+			expErr: fmt.Errorf(validateContainsAll, []string{"read", "write"}),
 		},
-		"empty int": {
-			val:    0,
-			expErr: errors.New(validateEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, ContainsAll(test.val, required...)())
+		})
+	}
+	is.NoErr(ContainsAll([]int{1, 2})())
+	is.Equal("values [3] are required but were not found", ContainsAll([]int{1, 2}, 1, 3)().Error())
+}
+
+func TestSubset(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	allowed := []string{"profile", "email", "openid"}
+	tt := map[string]struct {
+		val    []string
+		expErr error
+	}{
+		"valid subset should pass": {
+			val: []string{"openid", "email"},
 		},
-		"non-empty int8": {
-			val: int8(5),
+		"all allowed should pass": {
+			val: []string{"openid", "email", "profile"},
 		},
-		"empty int8": {
-			val:    int8(0),
-			expErr: errors.New(validateEmpty),
+		"empty slice should pass": {
+			val: []string{},
 		},
-		"non-empty int16": {
-			val: int16(5),
+		"stray element should fail": {
+			val:    []string{"openid", "admin", "root"},
+			expErr: fmt.Errorf(validateSubset, "admin"),
 		},
-		"empty int16": {
-			val:    int16(0),
-			expErr: errors.New(validateEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Subset(test.val, allowed...)())
+		})
+	}
+}
+
+func TestCountMatching(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	type address struct {
+		line1   string
+		primary bool
+	}
+	isPrimary := func(a address) bool { return a.primary }
+	tt := map[string]struct {
+		val    []address
+		expErr error
+	}{
+		"under count should fail": {
+			val:    []address{{line1: "1 High St"}, {line1: "2 High St"}},
+			expErr: fmt.Errorf(validateCountMatching, 0, 1, 1),
 		},
-		"non-empty int32": {
-			val: int32(5),
+		"empty slice should fail": {
+			expErr: fmt.Errorf(validateCountMatching, 0, 1, 1),
 		},
-		"empty int32": {
-			val:    int32(0),
-			expErr: errors.New(validateEmpty),
+		"in range count should pass": {
+			val: []address{{line1: "1 High St", primary: true}, {line1: "2 High St"}},
 		},
-		"non-empty int64": {
-			val: int64(5),
+		"over count should fail": {
+			val:    []address{{line1: "1 High St", primary: true}, {line1: "2 High St", primary: true}},
+			expErr: fmt.Errorf(validateCountMatching, 2, 1, 1),
 		},
-		"empty int64": {
-			val:    int64(0),
-			expErr: errors.New(validateEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, CountMatching(test.val, isPrimary, 1, 1)())
+		})
+	}
+}
+
+func TestMapHasKey(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    map[string]interface{}
+		key    string
+		expErr error
+	}{
+		"present key should pass": {
+			val: map[string]interface{}{"name": "bob"},
+			key: "name",
 		},
-		"non-empty uint": {
-			val: 235,
+		"present key with nil value should pass": {
+			val: map[string]interface{}{"name": nil},
+			key: "name",
 		},
-		"empty uint": {
-			val:    0,
-			expErr: errors.New(validateEmpty),
+		"absent key should fail": {
+			val:    map[string]interface{}{"name": "bob"},
+			key:    "dob",
+			expErr: fmt.Errorf(validateMapHasKey, "dob"),
 		},
-		"non-empty uint8": {
-			val: uint8(5),
+		"nil map should fail": {
+			key:    "dob",
+			expErr: fmt.Errorf(validateMapHasKey, "dob"),
 		},
-		"empty uint8": {
-			val:    uint8(0),
-			expErr: errors.New(validateEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, MapHasKey(test.val, test.key)())
+		})
+	}
+}
+
+func TestMapHasKeys(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    map[int]string
+		keys   []int
+		expErr error
+	}{
+		"all keys present should pass": {
+			val:  map[int]string{1: "a", 2: "b", 3: "c"},
+			keys: []int{1, 3},
 		},
-		"non-empty uint16": {
-			val: uint16(5),
+		"missing key should fail": {
+			val:    map[int]string{1: "a", 2: "b"},
+			keys:   []int{1, 3, 4},
+			expErr: fmt.Errorf(validateMapHasKey, 3),
 		},
-		"empty uint16": {
-			val:    uint16(0),
-			expErr: errors.New(validateEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, MapHasKeys(test.val, test.keys...)())
+		})
+	}
+}
+
+func TestAllWordsIn(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	allowed := map[string]struct{}{
+		"go":     {},
+		"rust":   {},
+		"python": {},
+	}
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"all words allowed should pass": {
+			val: "go  rust\tpython",
 		},
-		"non-empty uint32": {
-			val: uint32(5),
+		"empty string should pass": {
+			val: "",
 		},
-		"empty uint32": {
-			val:    uint32(0),
-			expErr: errors.New(validateEmpty),
+		"disallowed word should fail": {
+			val:    "go java rust",
+			expErr: fmt.Errorf(validateAllWordsIn, "java"),
 		},
-		"non-empty uint64": {
-			val: uint64(5),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, AllWordsIn(test.val, allowed)())
+		})
+	}
+}
+
+func TestNotInWordlist(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	words := []string{"admin", "root", ""}
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"clean string should pass": {
+			val: "flyingcodr",
 		},
-		"empty uint64": {
-			val:    uint64(0),
-			expErr: errors.New(validateEmpty),
+		"listed word should fail": {
+			val:    "admin",
+			expErr: fmt.Errorf(validateNotInWordlist, "admin"),
 		},
-		"non-empty float32": {
-			val: float32(5),
+		"listed word in upper case should fail": {
+			val:    "ROOT",
+			expErr: fmt.Errorf(validateNotInWordlist, "root"),
 		},
-		"empty float32": {
-			val:    float32(0),
-			expErr: errors.New(validateEmpty),
+		"listed word in mixed case substring should fail": {
+			val:    "SysAdmin42",
+			expErr: fmt.Errorf(validateNotInWordlist, "admin"),
 		},
-		"non-empty float64": {
-			val: float64(5),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, NotInWordlist(test.val, words...)())
+		})
+	}
+}
+
+func TestTemplatePlaceholdersResolved(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	data := map[string]string{
+		"name":  "Bob",
+		"order": "1234",
+	}
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"fully resolved template should pass": {
+			val: "Hi {{name}}, order {{ order }} has shipped",
 		},
-		"empty float64": {
-			val:    float64(0),
-			expErr: errors.New(validateEmpty),
+		"template without placeholders should pass": {
+			val: "Hi there",
 		},
-		"non-empty array": {
-			val: [2]string{"hello", "there"},
+		"missing keys should fail and be listed once": {
+			val:    "Hi {{name}}, {{eta}} {{courier}} {{eta}}",
+			expErr: fmt.Errorf(validatePlaceholder, []string{"eta", "courier"}),
 		},
-		"empty array": {
-			val:    [2]string{"", ""},
-			expErr: errors.New(validateEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, TemplatePlaceholdersResolved(test.val, data)())
+		})
+	}
+}
+
+func TestClosedRing(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		points [][2]float64
+		expErr error
+	}{
+		"closed triangle should pass": {
+			points: [][2]float64{{0, 0}, {10, 0}, {10, 10}, {0, 0}},
 		},
-		"non-empty slice": {
-			val: []string{"hello", "there"},
+		"unclosed ring should fail": {
+			points: [][2]float64{{0, 0}, {10, 0}, {10, 10}, {0, 10}},
+			expErr: errors.New(validateClosedRing),
 		},
-		"empty slice": {
-			val:    []string{},
-			expErr: errors.New(validateEmpty),
+		"too few points should fail": {
+			points: [][2]float64{{0, 0}, {10, 0}, {0, 0}},
+			expErr: errors.New(validateClosedRing),
 		},
-		"non-empty map": {
-			val: map[string]string{"hello": "there"},
+		"out of range latitude should fail": {
+			points: [][2]float64{{0, 0}, {10, 95}, {10, 10}, {0, 0}},
+			expErr: errors.New(validateClosedRing),
 		},
-		"empty map": {
-			val:    map[string]string{},
-			expErr: errors.New(validateEmpty),
+		"NaN coordinate should fail": {
+			points: [][2]float64{{0, 0}, {math.NaN(), 1}, {1, 1}, {0, 0}},
+			expErr: errors.New(validateClosedRing),
+		},
+		"nil ring should fail": {
+			expErr: errors.New(validateClosedRing),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, ClosedRing(test.points)())
+		})
+	}
+}
+
+func TestCSPNonce(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"16 byte nonce should pass": {
+			val: "MDEyMzQ1Njc4OWFiY2RlZg==",
+		},
+		"16 byte url safe nonce should pass": {
+			val: "-_-_-_-_-_-_-_-_-_-_-w==",
+		},
+		"16 byte unpadded nonce should pass": {
+			val: "AAAAAAAAAAAAAAAAAAAAAA",
+		},
+		"16 byte unpadded url safe nonce should pass": {
+			val: "-_-_-_-_-_-_-_-_-_-_-w",
+		},
+		"short unpadded nonce should fail": {
+			val:    "MDEyMzQ1Njc",
+			expErr: errors.New(validateCSPNonce),
+		},
+		"short nonce should fail": {
+			val:    "MDEyMzQ1Njc=",
+			expErr: errors.New(validateCSPNonce),
+		},
+		"invalid base64 should fail": {
+			val:    "not*base64!!",
+			expErr: errors.New(validateCSPNonce),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, CSPNonce(test.val)())
+		})
+	}
+}
+
+func TestLatLng(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val   []string
+		valid bool
+	}{
+		"valid pairs should pass": {
+			val:   []string{"51.5074,-0.1278", "-90,180", "90,-180", "0,0", " 40.7128 , -74.0060 "},
+			valid: true,
+		},
+		"invalid pairs should fail": {
+			val: []string{
+				"91,0", "-90.1,0", "0,180.5", "0,-181", "abc", "51.5074", "51.5,abc",
+				"1,2,3", "NaN,0", ",", "",
+			},
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			for _, v := range test.val {
+				err := LatLng(v)()
+				if test.valid {
+					is.NoErr(err)
+					continue
+				}
+				is.Equal(fmt.Errorf(validateLatLng, v), err)
+			}
+		})
+	}
+}
+
+func TestEnvVarName(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"upper case name should pass": {
+			val: "DATABASE_URL",
+		},
+		"leading underscore should pass": {
+			val: "_PRIVATE",
+		},
+		"leading digit should fail": {
+			val:    "1PORT",
+			expErr: fmt.Errorf(validateEnvVarName, "1PORT"),
+		},
+		"hyphen should fail": {
+			val:    "MY-VAR",
+			expErr: fmt.Errorf(validateEnvVarName, "MY-VAR"),
+		},
+		"empty name should fail": {
+			val:    "",
+			expErr: fmt.Errorf(validateEnvVarName, ""),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, EnvVarName(test.val)())
+		})
+	}
+}
+
+func TestEnvOverrides(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val map[string]string
+		exp ErrValidation
+	}{
+		"valid overrides should pass": {
+			val: map[string]string{
+				"LOG_LEVEL": "debug",
+				"PORT":      "8080",
+			},
+			exp: ErrValidation{},
+		},
+		"bad key and newline value should both be reported": {
+			val: map[string]string{
+				"LOG_LEVEL": "debug",
+				"MY-VAR":    "value",
+				"BANNER":    "hello\nworld",
+			},
+			exp: ErrValidation{
+				"MY-VAR": {fmt.Sprintf(validateEnvVarName, "MY-VAR")},
+				"BANNER": {validateNoNewline},
+			},
 		},
 	}
-
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, NotEmpty(test.val)())
+			is.Equal(test.exp, EnvOverrides(test.val))
 		})
 	}
 }
 
-func TestEmpty(t *testing.T) {
+func TestNot(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
 	tt := map[string]struct {
-		val    interface{}
+		fn     ValidationFunc
 		expErr error
 	}{
-		"nil ptr": {
-			val: nil,
-		},
-		"non-empty string": {
-			val:    "hello",
-			expErr: errors.New(validateNotEmpty),
+		"inverted failing Equal should pass": {
+			fn: Equal(1, 2),
 		},
-		"empty string": {
-			val: "",
+		"inverted passing Equal should fail": {
+			fn:     Equal(1, 1),
+			expErr: errors.New(validateNot),
 		},
-		"non-empty time": {
-			val:    time.Now(),
-			expErr: errors.New(validateNotEmpty),
+		"inverted failing Empty should pass": {
+			fn: Empty("hello"),
 		},
-		"empty time": {
-			val: time.Time{},
+		"inverted passing Empty should fail": {
+			fn:     Empty(""),
+			expErr: errors.New(validateNot),
 		},
-		"non-empty int": {
-			val:    235,
-			expErr: errors.New(validateNotEmpty),
+		"inverted failing MatchString should pass": {
+			fn: MatchString("oops", regexp.MustCompile(`^(pass|fail)$`)),
 		},
-		"empty int": {
-			val: 0,
+		"inverted passing MatchString should fail": {
+			fn:     MatchString("pass", regexp.MustCompile(`^(pass|fail)$`)),
+			expErr: errors.New(validateNot),
 		},
-		"non-empty int8": {
-			val:    int8(5),
-			expErr: errors.New(validateNotEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Not(test.fn)())
+		})
+	}
+}
+
+func TestOr(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		fns    []ValidationFunc
+		expErr error
+	}{
+		"one passing function should pass": {
+			fns: []ValidationFunc{Email("07123456789"), IsNumeric("07123456789")},
 		},
-		"empty int8": {
-			val: int8(0),
+		"all passing functions should pass": {
+			fns: []ValidationFunc{Equal(1, 1), PositiveNumber(1)},
 		},
-		"non-empty int16": {
-			val:    int16(5),
-			expErr: errors.New(validateNotEmpty),
+		"no functions should fail": {
+			expErr: fmt.Errorf(validateOr, ""),
 		},
-		"empty int16": {
-			val: int16(0),
+		"all failing functions should fail with all errors": {
+			fns: []ValidationFunc{Email("abc"), IsNumeric("abc")},
+			expErr: fmt.Errorf(validateOr,
+				validateEmail+", "+fmt.Sprintf(validateIsNumeric, "abc")),
 		},
-		"non-empty int32": {
-			val:    int32(5),
-			expErr: errors.New(validateNotEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Or(test.fns...)())
+		})
+	}
+}
+
+func TestAnd(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		fns    []ValidationFunc
+		expErr error
+	}{
+		"all passing functions should pass": {
+			fns: []ValidationFunc{Equal(1, 1), PositiveNumber(1)},
 		},
-		"empty int32": {
-			val: int32(0),
+		"no functions should pass": {},
+		"one failing function should fail": {
+			fns:    []ValidationFunc{Equal(1, 1), PositiveNumber(-1)},
+			expErr: fmt.Errorf(validatePositive, -1),
 		},
-		"non-empty int64": {
-			val:    int64(5),
-			expErr: errors.New(validateNotEmpty),
+		"all failing functions should return first error": {
+			fns:    []ValidationFunc{Email("abc"), IsNumeric("abc")},
+			expErr: errors.New(validateEmail),
 		},
-		"empty int64": {
-			val: int64(0),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, And(test.fns...)())
+		})
+	}
+}
+
+func TestWhen(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		cond   bool
+		fns    []ValidationFunc
+		expErr error
+	}{
+		"true condition with passing functions should pass": {
+			cond: true,
+			fns:  []ValidationFunc{NotEmpty("hello")},
 		},
-		"non-empty uint": {
-			val:    235,
-			expErr: errors.New(validateNotEmpty),
+		"true condition with failing functions should return first error": {
+			cond:   true,
+			fns:    []ValidationFunc{NotEmpty("hello"), NotEmpty(""), PositiveNumber(-1)},
+			expErr: errors.New(validateEmpty),
 		},
-		"empty uint": {
-			val: 0,
+		"false condition with failing functions should pass": {
+			cond: false,
+			fns:  []ValidationFunc{NotEmpty("")},
 		},
-		"non-empty uint8": {
-			val:    uint8(5),
-			expErr: errors.New(validateNotEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, When(test.cond, test.fns...)())
+		})
+	}
+}
+
+func TestUnless(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		cond   bool
+		fns    []ValidationFunc
+		expErr error
+	}{
+		"false condition with failing functions should fail": {
+			cond:   false,
+			fns:    []ValidationFunc{NotEmpty("")},
+			expErr: errors.New(validateEmpty),
 		},
-		"empty uint8": {
-			val: uint8(0),
+		"true condition with failing functions should pass": {
+			cond: true,
+			fns:  []ValidationFunc{NotEmpty("")},
 		},
-		"non-empty uint16": {
-			val:    uint16(5),
-			expErr: errors.New(validateNotEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Unless(test.cond, test.fns...)())
+		})
+	}
+}
+
+func TestRequiredIf(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		cond   bool
+		val    interface{}
+		expErr error
+	}{
+		"cond true and empty should fail": {
+			cond:   true,
+			val:    "",
+			expErr: errors.New(validateEmpty),
 		},
-		"empty uint16": {
-			val: uint16(0),
+		"cond true and nil should fail": {
+			cond:   true,
+			val:    nil,
+			expErr: errors.New(validateEmpty),
 		},
-		"non-empty uint32": {
-			val:    uint32(5),
-			expErr: errors.New(validateNotEmpty),
+		"cond true and present should pass": {
+			cond: true,
+			val:  "1 High Street",
 		},
-		"empty uint32": {
-			val: uint32(0),
+		"cond false and empty should pass": {
+			cond: false,
+			val:  "",
 		},
-		"non-empty uint64": {
-			val:    uint64(5),
-			expErr: errors.New(validateNotEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, RequiredIf(test.cond, test.val)())
+		})
+	}
+}
+
+func TestAtLeastOne(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		fields []interface{}
+		expErr error
+	}{
+		"all empty should fail": {
+			fields: []interface{}{"", "", nil},
+			expErr: fmt.Errorf(validateAtLeastOne, 3),
 		},
-		"empty uint64": {
-			val: uint64(0),
+		"no fields should fail": {
+			expErr: fmt.Errorf(validateAtLeastOne, 0),
 		},
-		"non-empty float32": {
-			val:    float32(5),
-			expErr: errors.New(validateNotEmpty),
+		"one present should pass": {
+			fields: []interface{}{"", "07700900123"},
 		},
-		"empty float32": {
-			val: float32(0),
+		"all present should pass": {
+			fields: []interface{}{"test@test.com", "07700900123"},
 		},
-		"non-empty float64": {
-			val:    float64(5),
-			expErr: errors.New(validateNotEmpty),
+		"mixed types should pass": {
+			fields: []interface{}{"", []string{"a"}},
 		},
-		"empty float64": {
-			val: float64(0),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, AtLeastOne(test.fields...)())
+		})
+	}
+}
+
+func TestOptional(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    string
+		expErr error
+	}{
+		"empty value should skip failing validator": {
+			val: "",
 		},
-		"non-empty array": {
-			val:    [2]string{"hello", "there"},
-			expErr: errors.New(validateNotEmpty),
+		"valid value should pass": {
+			val: "07123456789",
 		},
-		"empty array": {
-			val: [2]string{"", ""},
+		"invalid value should still be validated": {
+			val:    "abc",
+			expErr: fmt.Errorf(validateIsNumeric, "abc"),
 		},
-		"non-empty slice": {
-			val:    []string{"hello", "there"},
-			expErr: errors.New(validateNotEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, Optional(test.val, IsNumeric(test.val))())
+		})
+	}
+}
+
+func TestWithMessage(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		fn     ValidationFunc
+		msg    string
+		expErr error
+	}{
+		"passing function should return nil": {
+			fn:  Equal(true, true),
+			msg: "please accept the terms",
 		},
-		"empty slice": {
-			val: []string{},
+		"failing function should return custom message": {
+			fn:     Equal(false, true),
+			msg:    "please accept the terms",
+			expErr: errors.New("please accept the terms"),
 		},
-		"non-empty map": {
-			val:    map[string]string{"hello": "there"},
-			expErr: errors.New(validateNotEmpty),
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, WithMessage(test.fn, test.msg)())
+		})
+	}
+}
+
+func TestEach(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val    []string
+		expErr error
+	}{
+		"all valid elements should pass": {
+			val: []string{"a@test.com", "b@test.com"},
 		},
-		"empty map": {
-			val: map[string]string{},
+		"nil slice should pass": {},
+		"invalid elements should all be reported": {
+			val:    []string{"a@test.com", "oops", "b@test.com", ""},
+			expErr: errors.New("[1]: invalid email, [3]: invalid email"),
 		},
 	}
-
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, Empty(test.val)())
+			is.Equal(test.expErr, Each(test.val, func(e string) ValidationFunc {
+				return Email(e)
+			})())
 		})
 	}
 }
 
-func TestAny(t *testing.T) {
+func TestNoBidiControls(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
 	tt := map[string]struct {
 		val    string
-		list   []string
 		expErr error
 	}{
-		"matching item": {
-			val:  "hello",
-			list: []string{"wow", "hello", "ohwow"},
-		},
-		"missing item": {
-			val:    "hello",
-			list:   []string{"wow", "goodbye", "ohwow"},
-			expErr: errors.New("value not found in allowed values"),
+		"clean string should pass": {
+			val: "access_level := \"user\" // héllo",
 		},
-		"empty string found": {
-			val:  "",
-			list: []string{"wow", "", "ohwow"},
+		"right to left override should fail": {
+			val:    "access_level != \"user\u202E \u2066// Check if admin\u2069 \u2066\"",
+			expErr: errors.New(validateNoBidi),
 		},
-		"empty string not found": {
-			val:    "",
-			list:   []string{"wow", "ohwow"},
-			expErr: errors.New("value not found in allowed values"),
+		"right to left mark should fail": {
+			val:    "abc\u200Fdef",
+			expErr: errors.New(validateNoBidi),
 		},
 	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, NoBidiControls(test.val)())
+		})
+	}
+}
 
+func TestBackoffConsistent(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		initial    time.Duration
+		max        time.Duration
+		multiplier float64
+		expErr     error
+	}{
+		"valid config should pass": {
+			initial:    time.Second,
+			max:        time.Minute,
+			multiplier: 1.5,
+		},
+		"initial equal to max should pass": {
+			initial:    time.Second,
+			max:        time.Second,
+			multiplier: 2,
+		},
+		"initial above max and low multiplier should report both": {
+			initial:    time.Minute,
+			max:        time.Second,
+			multiplier: 1,
+			expErr: errors.New(fmt.Sprintf(validateBackoffOrder, time.Minute, time.Second) + ", " +
+				fmt.Sprintf(validateBackoffMultiplier, 1.0)),
+		},
+		"non positive values should fail": {
+			initial:    0,
+			max:        -time.Second,
+			multiplier: -1,
+			expErr: errors.New(validateBackoffInitial + ", " + validateBackoffMax + ", " +
+				fmt.Sprintf(validateBackoffOrder, time.Duration(0), -time.Second) + ", " +
+				fmt.Sprintf(validateBackoffMultiplier, -1.0)),
+		},
+	}
 	for name, test := range tt {
 		t.Run(name, func(t *testing.T) {
 			is = is.NewRelaxed(t)
-			is.Equal(test.expErr, Any(test.val, test.list...)())
+			is.Equal(test.expErr, BackoffConsistent(test.initial, test.max, test.multiplier)())
 		})
 	}
 }
@@ -5,3 +5,8 @@ go 1.18
 require github.com/matryer/is v1.4.0
 
 require golang.org/x/exp v0.0.0-20220823124025-807a23277127
+
+require (
+	golang.org/x/crypto v0.21.0
+	golang.org/x/sys v0.18.0 // indirect
+)
@@ -0,0 +1,286 @@
+package validator
+
+import "sync"
+
+// MessageKey identifies a built in validation message. The message for a
+// key can be replaced, for example to translate it, by calling SetMessages.
+type MessageKey string
+
+// Keys for each of the built in validation messages.
+const (
+	MessageNotEmpty           MessageKey = "not_empty"
+	MessageEmpty              MessageKey = "empty"
+	MessageLength             MessageKey = "length"
+	MessageExactLength        MessageKey = "exact_length"
+	MessageSliceLength        MessageKey = "slice_length"
+	MessageSliceExactLength   MessageKey = "slice_exact_length"
+	MessageMinItems           MessageKey = "min_items"
+	MessageMaxItems           MessageKey = "max_items"
+	MessageSorted             MessageKey = "sorted"
+	MessageSliceContains      MessageKey = "slice_contains"
+	MessageMapHasKey          MessageKey = "map_has_key"
+	MessageMin                MessageKey = "min"
+	MessageMax                MessageKey = "max"
+	MessageNumBetween         MessageKey = "num_between"
+	MessagePositive           MessageKey = "positive"
+	MessagePrecision          MessageKey = "precision"
+	MessagePercentOf          MessageKey = "percent_of"
+	MessageOrderMin           MessageKey = "order_min"
+	MessageOrderPack          MessageKey = "order_pack"
+	MessageRegex              MessageKey = "regex"
+	MessageBool               MessageKey = "bool"
+	MessageDateEqual          MessageKey = "date_equal"
+	MessageDateAfter          MessageKey = "date_after"
+	MessageDateBefore         MessageKey = "date_before"
+	MessageDateAfterEq        MessageKey = "date_after_eq"
+	MessageDateBeforeEq       MessageKey = "date_before_eq"
+	MessageDateWithin         MessageKey = "date_within"
+	MessageWeekday            MessageKey = "weekday"
+	MessageWeekend            MessageKey = "weekend"
+	MessageWeekdayIn          MessageKey = "weekday_in"
+	MessageDateString         MessageKey = "date_string"
+	MessageSameDay            MessageKey = "same_day"
+	MessageBackoffInitial     MessageKey = "backoff_initial"
+	MessageBackoffMax         MessageKey = "backoff_max"
+	MessageBackoffOrder       MessageKey = "backoff_order"
+	MessageBackoffMultiplier  MessageKey = "backoff_multiplier"
+	MessageUKPostCode         MessageKey = "uk_post_code"
+	MessageZipCode            MessageKey = "zip_code"
+	MessageHasPrefix          MessageKey = "has_prefix"
+	MessageNoPrefix           MessageKey = "no_prefix"
+	MessageHex                MessageKey = "hex"
+	MessageAny                MessageKey = "any"
+	MessageIsNumeric          MessageKey = "is_numeric"
+	MessageEmail              MessageKey = "email"
+	MessageAllWordsIn         MessageKey = "all_words_in"
+	MessagePlaceholder        MessageKey = "placeholder"
+	MessageClosedRing         MessageKey = "closed_ring"
+	MessageCSPNonce           MessageKey = "csp_nonce"
+	MessageEnvVarName         MessageKey = "env_var_name"
+	MessageExtension          MessageKey = "extension"
+	MessageMACOUI             MessageKey = "mac_oui"
+	MessageFlagKey            MessageKey = "flag_key"
+	MessageNoNewline          MessageKey = "no_newline"
+	MessageNoBidi             MessageKey = "no_bidi"
+	MessageNot                MessageKey = "not"
+	MessageOr                 MessageKey = "or"
+	MessageBlank              MessageKey = "blank"
+	MessageContainsDigit      MessageKey = "contains_digit"
+	MessageContainsUpper      MessageKey = "contains_upper"
+	MessageContainsLower      MessageKey = "contains_lower"
+	MessageMatchAny           MessageKey = "match_any"
+	MessageNotMatch           MessageKey = "not_match"
+	MessageMinLength          MessageKey = "min_length"
+	MessageMaxLength          MessageKey = "max_length"
+	MessageNotStruct          MessageKey = "not_struct"
+	MessageUnknownRule        MessageKey = "unknown_rule"
+	MessageUnsupportedRule    MessageKey = "unsupported_rule"
+	MessageRuleParam          MessageKey = "rule_param"
+	MessageDateNotBetween     MessageKey = "date_not_between"
+	MessageConfirm            MessageKey = "confirm"
+	MessageNonNegative        MessageKey = "non_negative"
+	MessageNonPositive        MessageKey = "non_positive"
+	MessageASCII              MessageKey = "ascii"
+	MessagePrintableASCII     MessageKey = "printable_ascii"
+	MessageNoControlChars     MessageKey = "no_control_chars"
+	MessageRequiredKeys       MessageKey = "required_keys"
+	MessageCAPostalCode       MessageKey = "ca_postal_code"
+	MessagePostalCode         MessageKey = "postal_code"
+	MessagePostalCodeCountry  MessageKey = "postal_code_country"
+	MessageDataURI            MessageKey = "data_uri"
+	MessageMIMEType           MessageKey = "mime_type"
+	MessageMIMETypeIn         MessageKey = "mime_type_in"
+	MessageCronFields         MessageKey = "cron_fields"
+	MessageCronField          MessageKey = "cron_field"
+	MessageEthereumAddress    MessageKey = "ethereum_address"
+	MessageEthereumChecksum   MessageKey = "ethereum_checksum"
+	MessageBech32             MessageKey = "bech32"
+	MessageTimezone           MessageKey = "timezone"
+	MessageLanguageTag        MessageKey = "language_tag"
+	MessageNumericOverflow    MessageKey = "numeric_overflow"
+	MessageIsFloat            MessageKey = "is_float"
+	MessageIsBool             MessageKey = "is_bool"
+	MessageParseUint          MessageKey = "parse_uint"
+	MessageUintOverflow       MessageKey = "uint_overflow"
+	MessageCardExpiryMonth    MessageKey = "card_expiry_month"
+	MessageCardExpired        MessageKey = "card_expired"
+	MessageUsernameChars      MessageKey = "username_chars"
+	MessageUsernameDigit      MessageKey = "username_digit"
+	MessageDomain             MessageKey = "domain"
+	MessageEmailDomain        MessageKey = "email_domain"
+	MessageEmailDomainBlocked MessageKey = "email_domain_blocked"
+	MessageVATNumber          MessageKey = "vat_number"
+	MessageVATCountry         MessageKey = "vat_country"
+	MessageSSN                MessageKey = "ssn"
+	MessageAtLeastOne         MessageKey = "at_least_one"
+	MessageNumericLength      MessageKey = "numeric_length"
+	MessageIMEI               MessageKey = "imei"
+	MessageLatLng             MessageKey = "lat_lng"
+	MessageTrimmed            MessageKey = "trimmed"
+	MessageNotInWordlist      MessageKey = "not_in_wordlist"
+	MessageContainsAll        MessageKey = "contains_all"
+	MessageSubset             MessageKey = "subset"
+	MessageCountMatching      MessageKey = "count_matching"
+	MessageIn                 MessageKey = "in"
+	MessagePattern            MessageKey = "pattern"
+	MessageByteLength         MessageKey = "byte_length"
+	MessageLuhn               MessageKey = "luhn"
+)
+
+// messages holds the current message for each key, it is package level so that
+// SetMessages applies to every validator and is guarded by messagesMu.
+var (
+	messagesMu sync.RWMutex        //nolint:gochecknoglobals // guards messages
+	messages   = defaultMessages() //nolint:gochecknoglobals // replaced by SetMessages
+)
+
+// defaultMessages returns the English messages used when no override is set.
+func defaultMessages() map[MessageKey]string {
+	return map[MessageKey]string{
+		MessageNotEmpty:           validateEmpty,
+		MessageEmpty:              validateNotEmpty,
+		MessageLength:             validateLength,
+		MessageExactLength:        validateExactLength,
+		MessageSliceLength:        validateSliceLength,
+		MessageSliceExactLength:   validateSliceExactLength,
+		MessageMinItems:           validateMinItems,
+		MessageMaxItems:           validateMaxItems,
+		MessageSorted:             validateSorted,
+		MessageSliceContains:      validateSliceContains,
+		MessageMapHasKey:          validateMapHasKey,
+		MessageMin:                validateMin,
+		MessageMax:                validateMax,
+		MessageNumBetween:         validateNumBetween,
+		MessagePositive:           validatePositive,
+		MessagePrecision:          validatePrecision,
+		MessagePercentOf:          validatePercentOf,
+		MessageOrderMin:           validateOrderMin,
+		MessageOrderPack:          validateOrderPack,
+		MessageRegex:              validateRegex,
+		MessageBool:               validateBool,
+		MessageDateEqual:          validateDateEqual,
+		MessageDateAfter:          validateDateAfter,
+		MessageDateBefore:         validateDateBefore,
+		MessageDateAfterEq:        validateDateAfterEq,
+		MessageDateBeforeEq:       validateDateBeforeEq,
+		MessageDateWithin:         validateDateWithin,
+		MessageWeekday:            validateWeekday,
+		MessageWeekend:            validateWeekend,
+		MessageWeekdayIn:          validateWeekdayIn,
+		MessageDateString:         validateDateString,
+		MessageSameDay:            validateSameDay,
+		MessageBackoffInitial:     validateBackoffInitial,
+		MessageBackoffMax:         validateBackoffMax,
+		MessageBackoffOrder:       validateBackoffOrder,
+		MessageBackoffMultiplier:  validateBackoffMultiplier,
+		MessageUKPostCode:         validateUkPostCode,
+		MessageZipCode:            validateZipCode,
+		MessageHasPrefix:          validateHasPrefix,
+		MessageNoPrefix:           validateNoPrefix,
+		MessageHex:                validateHex,
+		MessageAny:                validateAny,
+		MessageIsNumeric:          validateIsNumeric,
+		MessageEmail:              validateEmail,
+		MessageAllWordsIn:         validateAllWordsIn,
+		MessagePlaceholder:        validatePlaceholder,
+		MessageClosedRing:         validateClosedRing,
+		MessageCSPNonce:           validateCSPNonce,
+		MessageEnvVarName:         validateEnvVarName,
+		MessageExtension:          validateExtension,
+		MessageMACOUI:             validateMACOUI,
+		MessageFlagKey:            validateFlagKey,
+		MessageNoNewline:          validateNoNewline,
+		MessageNoBidi:             validateNoBidi,
+		MessageNot:                validateNot,
+		MessageOr:                 validateOr,
+		MessageBlank:              validateBlank,
+		MessageContainsDigit:      validateContainsDigit,
+		MessageContainsUpper:      validateContainsUpper,
+		MessageContainsLower:      validateContainsLower,
+		MessageMatchAny:           validateMatchAny,
+		MessageNotMatch:           validateNotMatch,
+		MessageMinLength:          validateMinLength,
+		MessageMaxLength:          validateMaxLength,
+		MessageNotStruct:          validateNotStruct,
+		MessageUnknownRule:        validateUnknownRule,
+		MessageUnsupportedRule:    validateUnsupportedRule,
+		MessageRuleParam:          validateRuleParam,
+		MessageDateNotBetween:     validateDateNotBetween,
+		MessageConfirm:            validateConfirm,
+		MessageNonNegative:        validateNonNegative,
+		MessageNonPositive:        validateNonPositive,
+		MessageASCII:              validateASCII,
+		MessagePrintableASCII:     validatePrintableASCII,
+		MessageNoControlChars:     validateNoControlChars,
+		MessageRequiredKeys:       validateRequiredKeys,
+		MessageCAPostalCode:       validateCAPostalCode,
+		MessagePostalCode:         validatePostalCode,
+		MessagePostalCodeCountry:  validatePostalCodeCountry,
+		MessageDataURI:            validateDataURI,
+		MessageMIMEType:           validateMIMEType,
+		MessageMIMETypeIn:         validateMIMETypeIn,
+		MessageCronFields:         validateCronFields,
+		MessageCronField:          validateCronField,
+		MessageEthereumAddress:    validateEthereumAddress,
+		MessageEthereumChecksum:   validateEthereumChecksum,
+		MessageBech32:             validateBech32,
+		MessageTimezone:           validateTimezone,
+		MessageLanguageTag:        validateLanguageTag,
+		MessageNumericOverflow:    validateNumericOverflow,
+		MessageIsFloat:            validateIsFloat,
+		MessageIsBool:             validateIsBool,
+		MessageParseUint:          validateParseUint,
+		MessageUintOverflow:       validateUintOverflow,
+		MessageCardExpiryMonth:    validateCardExpiryMonth,
+		MessageCardExpired:        validateCardExpired,
+		MessageUsernameChars:      validateUsernameChars,
+		MessageUsernameDigit:      validateUsernameDigit,
+		MessageDomain:             validateDomain,
+		MessageEmailDomain:        validateEmailDomain,
+		MessageEmailDomainBlocked: validateEmailDomainBlocked,
+		MessageVATNumber:          validateVATNumber,
+		MessageVATCountry:         validateVATCountry,
+		MessageSSN:                validateSSN,
+		MessageAtLeastOne:         validateAtLeastOne,
+		MessageNumericLength:      validateNumericLength,
+		MessageIMEI:               validateIMEI,
+		MessageLatLng:             validateLatLng,
+		MessageTrimmed:            validateTrimmed,
+		MessageNotInWordlist:      validateNotInWordlist,
+		MessageContainsAll:        validateContainsAll,
+		MessageSubset:             validateSubset,
+		MessageCountMatching:      validateCountMatching,
+		MessageIn:                 validateIn,
+		MessagePattern:            validatePattern,
+		MessageByteLength:         validateByteLength,
+		MessageLuhn:               validateLuhn,
+	}
+}
+
+// SetMessages will replace the messages for each key supplied in m, any keys
+// not supplied keep their current message. Replacement messages should contain
+// the same format verbs, in the same order, as the message they replace.
+//
+//	validator.SetMessages(map[validator.MessageKey]string{
+//	    validator.MessageNotEmpty: "la valeur ne peut pas être vide",
+//	})
+//
+// Passing nil will restore the default messages.
+func SetMessages(m map[MessageKey]string) {
+	messagesMu.Lock()
+	defer messagesMu.Unlock()
+	if m == nil {
+		messages = defaultMessages()
+		return
+	}
+	for k, v := range m {
+		messages[k] = v
+	}
+}
+
+// message returns the current message for key.
+func message(key MessageKey) string {
+	messagesMu.RLock()
+	defer messagesMu.RUnlock()
+	return messages[key]
+}
@@ -0,0 +1,37 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+// TestSetMessages is not run in parallel as it changes the package level messages.
+func TestSetMessages(t *testing.T) {
+	is := is.New(t)
+	defer SetMessages(nil)
+
+	SetMessages(map[MessageKey]string{
+		MessageNotEmpty: "la valeur ne peut pas être vide",
+		MessageLength:   "la valeur doit contenir entre %d et %d caractères",
+	})
+	is.Equal(errors.New("la valeur ne peut pas être vide"), NotEmpty("")())
+	is.Equal(fmt.Errorf("la valeur doit contenir entre %d et %d caractères", 4, 10), StrLength("abc", 4, 10)())
+	// keys not supplied should keep the default
+	is.Equal(fmt.Errorf(validatePositive, -1), PositiveNumber(-1)())
+
+	SetMessages(nil)
+	is.Equal(errors.New(validateEmpty), NotEmpty("")())
+	is.Equal(fmt.Errorf(validateLength, 4, 10), StrLength("abc", 4, 10)())
+}
+
+func TestDefaultMessages(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	for k, v := range defaultMessages() {
+		is.True(k != "")
+		is.True(v != "")
+	}
+}
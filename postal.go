@@ -0,0 +1,56 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	reDEPostCode = regexp.MustCompile(`^\d{5}$`)
+	reFRPostCode = regexp.MustCompile(`^(?:0[1-9]|[1-8]\d|9[0-8])\d{3}$`)
+	reNLPostCode = regexp.MustCompile(`(?i)^[1-9]\d{3} ?[A-Z]{2}$`)
+	reAUPostCode = regexp.MustCompile(`^\d{4}$`)
+)
+
+// postalCodePattern returns the pattern used to validate postal codes for the
+// upper case ISO 3166-1 alpha-2 country code, countryCode, or false if the
+// country is not supported.
+func postalCodePattern(countryCode string) (*regexp.Regexp, bool) {
+	switch countryCode {
+	case "GB":
+		return reUKPostCode, true
+	case "US":
+		return reZipCode, true
+	case "CA":
+		return reCAPostCode, true
+	case "DE":
+		return reDEPostCode, true
+	case "FR":
+		return reFRPostCode, true
+	case "NL":
+		return reNLPostCode, true
+	case "AU":
+		return reAUPostCode, true
+	}
+	return nil, false
+}
+
+// PostalCode will validate that a string, val, matches the postal code
+// pattern for the country identified by its ISO 3166-1 alpha-2 code,
+// countryCode, such as "GB" or "us".
+// Supported countries are GB, US, CA, DE, FR, NL and AU, any other
+// country will return an error rather than pass.
+// It does not check the postal code exists, just that it matches the pattern.
+func PostalCode(val, countryCode string) ValidationFunc {
+	return func() error {
+		re, ok := postalCodePattern(strings.ToUpper(countryCode))
+		if !ok {
+			return fmt.Errorf(message(MessagePostalCodeCountry), countryCode)
+		}
+		if re.MatchString(val) {
+			return nil
+		}
+		return fmt.Errorf(message(MessagePostalCode), val, countryCode)
+	}
+}
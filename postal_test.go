@@ -0,0 +1,100 @@
+package validator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestPostalCode(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val     string
+		country string
+		expErr  error
+	}{
+		"valid GB postcode should pass": {
+			val:     "SW1A 1AA",
+			country: "GB",
+		},
+		"invalid GB postcode should fail": {
+			val:     "12345",
+			country: "GB",
+			expErr:  fmt.Errorf(validatePostalCode, "12345", "GB"),
+		},
+		"valid US zip code should pass": {
+			val:     "12201-7050",
+			country: "US",
+		},
+		"lower case country code should pass": {
+			val:     "90210",
+			country: "us",
+		},
+		"valid CA postal code should pass": {
+			val:     "K1A 0B1",
+			country: "CA",
+		},
+		"invalid CA postal code should fail": {
+			val:     "D1A 0B1",
+			country: "CA",
+			expErr:  fmt.Errorf(validatePostalCode, "D1A 0B1", "CA"),
+		},
+		"valid DE postal code should pass": {
+			val:     "10115",
+			country: "DE",
+		},
+		"short DE postal code should fail": {
+			val:     "1011",
+			country: "DE",
+			expErr:  fmt.Errorf(validatePostalCode, "1011", "DE"),
+		},
+		"valid FR postal code should pass": {
+			val:     "75008",
+			country: "FR",
+		},
+		"FR postal code with 00 department should fail": {
+			val:     "00123",
+			country: "FR",
+			expErr:  fmt.Errorf(validatePostalCode, "00123", "FR"),
+		},
+		"valid NL postal code should pass": {
+			val:     "1012 AB",
+			country: "NL",
+		},
+		"NL postal code without space should pass": {
+			val:     "1012ab",
+			country: "NL",
+		},
+		"NL postal code with leading zero should fail": {
+			val:     "0123 AB",
+			country: "NL",
+			expErr:  fmt.Errorf(validatePostalCode, "0123 AB", "NL"),
+		},
+		"valid AU postcode should pass": {
+			val:     "2000",
+			country: "AU",
+		},
+		"AU postcode with letters should fail": {
+			val:     "20A0",
+			country: "AU",
+			expErr:  fmt.Errorf(validatePostalCode, "20A0", "AU"),
+		},
+		"unsupported country should fail": {
+			val:     "100-0001",
+			country: "JP",
+			expErr:  fmt.Errorf(validatePostalCodeCountry, "JP"),
+		},
+		"empty country should fail": {
+			val:    "12345",
+			expErr: fmt.Errorf(validatePostalCodeCountry, ""),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, PostalCode(test.val, test.country)())
+		})
+	}
+}
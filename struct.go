@@ -0,0 +1,236 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ValidateStruct will validate the exported fields of a struct, v, using the
+// rules supplied in a validate struct tag. Errors are keyed by the json tag
+// name of a field, falling back to the field name if there isn't one.
+//
+//	type Request struct {
+//	    Name  string `json:"name" validate:"required,min=4,max=10"`
+//	    Email string `json:"email" validate:"email"`
+//	    Count int    `json:"count" validate:"positive"`
+//	}
+//
+// This can then be evaluated by calling:
+//
+//	err := validator.ValidateStruct(req).Err()
+//
+// Rules are comma separated, supported rules are:
+// required: value is not empty, as per NotEmpty
+// email: string is a valid email, as per Email
+// numeric: string is an int, as per IsNumeric
+// hex: string is valid hex, as per IsHex
+// positive: number is greater than 0
+// min=n: string has at least n characters, slice or map has at least n items, number is at least n
+// max=n: string has at most n characters, slice or map has at most n items, number is at most n
+// len=n: string has exactly n characters, slice or map has exactly n items
+//
+// Nil pointer fields are only checked by the required rule. An unknown rule,
+// an invalid rule parameter or a rule that can't be applied to the type of the
+// field is recorded as an error against that field rather than passing.
+//
+// Fields that implement Validator, or slices and arrays of them, are also
+// evaluated and their errors merged using a dotted path such as
+// "address.postcode" or "items[0].name". A validate tag of "-" skips a field
+// entirely.
+func ValidateStruct(v interface{}) ErrValidation {
+	e := New()
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return e.Validate("", ruleError(fmt.Errorf(message(MessageNotStruct), reflect.TypeOf(v))))
+	}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		tag := f.Tag.Get("validate")
+		if !f.IsExported() || tag == "-" {
+			continue
+		}
+		name := structFieldName(f)
+		if tag != "" {
+			fns := make([]ValidationFunc, 0)
+			for _, rule := range strings.Split(tag, ",") {
+				rule, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+				fns = append(fns, structRule(rv.Field(i), rule, param))
+			}
+			e.Validate(name, fns...)
+		}
+		structNested(e, name, rv.Field(i))
+	}
+	return e
+}
+
+// structNested will evaluate a field value, fv, if it implements Validator,
+// or each element of it if it is a slice or array of Validators.
+func structNested(e ErrValidation, name string, fv reflect.Value) {
+	if v, ok := structValidator(fv); ok {
+		e.ValidateNested(name, v)
+		return
+	}
+	if fv.Kind() != reflect.Slice && fv.Kind() != reflect.Array {
+		return
+	}
+	vt := reflect.TypeOf((*Validator)(nil)).Elem()
+	if et := fv.Type().Elem(); !et.Implements(vt) && !reflect.PtrTo(et).Implements(vt) {
+		return
+	}
+	for i := 0; i < fv.Len(); i++ {
+		if v, ok := structValidator(fv.Index(i)); ok {
+			e.ValidateNested(IndexedField(name, i), v)
+		}
+	}
+}
+
+// isNilValue returns true if fv is a nillable kind, such as a pointer, holding nil.
+func isNilValue(fv reflect.Value) bool {
+	//nolint:exhaustive // only nillable kinds need checking
+	switch fv.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return fv.IsNil()
+	}
+	return false
+}
+
+// structValidator returns fv as a Validator if either it or a pointer to it
+// implements the interface. Nil values are never returned.
+func structValidator(fv reflect.Value) (Validator, bool) {
+	if isNilValue(fv) {
+		return nil, false
+	}
+	if v, ok := fv.Interface().(Validator); ok {
+		return v, true
+	}
+	if fv.Kind() == reflect.Ptr {
+		return nil, false
+	}
+	ptr := reflect.New(fv.Type())
+	ptr.Elem().Set(fv)
+	v, ok := ptr.Interface().(Validator)
+	return v, ok
+}
+
+// structFieldName returns the json name of a field or the field name if not set.
+func structFieldName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return f.Name
+	}
+	return name
+}
+
+// structRule returns the ValidationFunc for a single rule applied to a field value, fv.
+func structRule(fv reflect.Value, rule, param string) ValidationFunc {
+	if rule == "required" {
+		return NotEmpty(fv.Interface())
+	}
+	for fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			return func() error { return nil }
+		}
+		fv = fv.Elem()
+	}
+	switch rule {
+	case "email", "numeric", "hex":
+		if fv.Kind() != reflect.String {
+			return ruleError(fmt.Errorf(message(MessageUnsupportedRule), rule, fv.Type()))
+		}
+		switch rule {
+		case "email":
+			return Email(fv.String())
+		case "numeric":
+			return IsNumeric(fv.String())
+		default:
+			return IsHex(fv.String())
+		}
+	case "positive":
+		n, ok := structNumber(fv)
+		if !ok {
+			return ruleError(fmt.Errorf(message(MessageUnsupportedRule), rule, fv.Type()))
+		}
+		return PositiveNumber(n)
+	case "min", "max", "len":
+		return structBound(fv, rule, param)
+	}
+	return ruleError(fmt.Errorf(message(MessageUnknownRule), rule))
+}
+
+// structBound applies a min, max or len rule to the length of strings, slices,
+// arrays and maps or to the value of numbers.
+func structBound(fv reflect.Value, rule, param string) ValidationFunc {
+	//nolint:exhaustive // only lengths and numbers are bounded
+	switch fv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		p, err := strconv.Atoi(param)
+		if err != nil {
+			return ruleError(fmt.Errorf(message(MessageRuleParam), rule, param))
+		}
+		l := fv.Len()
+		keys := [3]MessageKey{MessageMinItems, MessageMaxItems, MessageSliceExactLength}
+		if fv.Kind() == reflect.String {
+			l = utf8.RuneCountInString(fv.String())
+			keys = [3]MessageKey{MessageMinLength, MessageMaxLength, MessageExactLength}
+		}
+		switch rule {
+		case "min":
+			return lengthCheck(l >= p, keys[0], p)
+		case "max":
+			return lengthCheck(l <= p, keys[1], p)
+		default:
+			return lengthCheck(l == p, keys[2], p)
+		}
+	}
+	n, ok := structNumber(fv)
+	if !ok || rule == "len" {
+		return ruleError(fmt.Errorf(message(MessageUnsupportedRule), rule, fv.Type()))
+	}
+	p, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return ruleError(fmt.Errorf(message(MessageRuleParam), rule, param))
+	}
+	if rule == "min" {
+		return MinNumber(n, p)
+	}
+	return MaxNumber(n, p)
+}
+
+// structNumber returns the value of a numeric field as a float64, ok is false
+// if the field is not a number.
+func structNumber(fv reflect.Value) (float64, bool) {
+	//nolint:exhaustive // only numbers are supported
+	switch fv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(fv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(fv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return fv.Float(), true
+	}
+	return 0, false
+}
+
+func lengthCheck(ok bool, key MessageKey, n int) ValidationFunc {
+	return func() error {
+		if ok {
+			return nil
+		}
+		return fmt.Errorf(message(key), n)
+	}
+}
+
+// ruleError returns a ValidationFunc that always fails with err, it is used
+// to report invalid rules.
+func ruleError(err error) ValidationFunc {
+	return func() error {
+		return err
+	}
+}
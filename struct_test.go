@@ -0,0 +1,222 @@
+package validator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+type testTagged struct {
+	Name     string            `json:"name" validate:"required,min=4,max=10"`
+	Email    string            `json:"email,omitempty" validate:"email"`
+	Count    int               `json:"count" validate:"positive,max=100"`
+	Ratio    float64           `json:"ratio" validate:"min=0.5"`
+	Code     string            `validate:"len=3,numeric"`
+	Tags     []string          `json:"tags" validate:"min=1,max=2"`
+	Meta     map[string]string `json:"meta" validate:"len=1"`
+	Nickname *string           `json:"nickname" validate:"min=2"`
+	Ignored  string            `json:"ignored"`
+	hidden   string            `validate:"required"`
+}
+
+func TestValidateStruct(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	short := "x"
+	tt := map[string]struct {
+		val interface{}
+		exp ErrValidation
+	}{
+		"valid struct should pass": {
+			val: testTagged{
+				Name:  "My Name",
+				Email: "test@test.com",
+				Count: 10,
+				Ratio: 0.5,
+				Code:  "123",
+				Tags:  []string{"a"},
+				Meta:  map[string]string{"a": "b"},
+			},
+			exp: ErrValidation{},
+		},
+		"valid struct pointer should pass": {
+			val: &testTagged{
+				Name:  "My Name",
+				Email: "test@test.com",
+				Count: 10,
+				Ratio: 1,
+				Code:  "123",
+				Tags:  []string{"a"},
+				Meta:  map[string]string{"a": "b"},
+			},
+			exp: ErrValidation{},
+		},
+		"invalid struct should record each failure": {
+			val: testTagged{
+				Email:    "test@",
+				Count:    101,
+				Ratio:    0.1,
+				Code:     "12a",
+				Tags:     []string{"a", "b", "c"},
+				Nickname: &short,
+			},
+			exp: ErrValidation{
+				"name":     {validateEmpty, fmt.Sprintf(validateMinLength, 4)},
+				"email":    {validateEmail},
+				"count":    {fmt.Sprintf(validateMax, 101.0, 100.0)},
+				"ratio":    {fmt.Sprintf(validateMin, 0.1, 0.5)},
+				"Code":     {fmt.Sprintf(validateIsNumeric, "12a")},
+				"tags":     {fmt.Sprintf(validateMaxItems, 2)},
+				"meta":     {fmt.Sprintf(validateSliceExactLength, 1)},
+				"nickname": {fmt.Sprintf(validateMinLength, 2)},
+			},
+		},
+		"non struct should fail": {
+			val: "hello",
+			exp: ErrValidation{
+				"": {fmt.Sprintf(validateNotStruct, "string")},
+			},
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.exp, ValidateStruct(test.val))
+		})
+	}
+}
+
+func TestValidateStructBadRules(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val interface{}
+		exp ErrValidation
+	}{
+		"unknown rule should fail": {
+			val: struct {
+				Name string `json:"name" validate:"required,shiny"`
+			}{Name: "hello"},
+			exp: ErrValidation{
+				"name": {fmt.Sprintf(validateUnknownRule, "shiny")},
+			},
+		},
+		"invalid parameter should fail": {
+			val: struct {
+				Name string `json:"name" validate:"min=abc"`
+			}{Name: "hello"},
+			exp: ErrValidation{
+				"name": {fmt.Sprintf(validateRuleParam, "min", "abc")},
+			},
+		},
+		"unsupported type should fail": {
+			val: struct {
+				Count int `json:"count" validate:"email,len=2"`
+			}{Count: 1},
+			exp: ErrValidation{
+				"count": {
+					fmt.Sprintf(validateUnsupportedRule, "email", "int"),
+					fmt.Sprintf(validateUnsupportedRule, "len", "int"),
+				},
+			},
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.exp, ValidateStruct(test.val))
+		})
+	}
+}
+
+func TestValidateStructErr(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	err := ValidateStruct(struct {
+		Email string `json:"email" validate:"required,email"`
+	}{}).Err()
+	is.True(HasFieldError(err, "email"))
+	is.Equal(err, ErrValidation{"email": {validateEmpty, validateEmail}})
+}
+
+type testLine struct {
+	Name     string `json:"name"`
+	Quantity int    `json:"quantity"`
+}
+
+func (l *testLine) Validate() ErrValidation {
+	return New().
+		Validate("name", NotEmpty(l.Name)).
+		Validate("quantity", PositiveNumber(l.Quantity))
+}
+
+type testOrder struct {
+	Reference string       `json:"reference" validate:"required"`
+	Address   testAddress  `json:"address"`
+	Billing   *testAddress `json:"billing"`
+	Lines     []testLine   `json:"lines" validate:"min=1"`
+	Skipped   testAddress  `json:"skipped" validate:"-"`
+}
+
+func TestValidateStructNested(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	validAddress := testAddress{Line1: "1 The Street", PostCode: "NW1A 1AA"}
+	tt := map[string]struct {
+		val testOrder
+		exp ErrValidation
+	}{
+		"valid nested struct should pass": {
+			val: testOrder{
+				Reference: "abc",
+				Address:   validAddress,
+				Lines:     []testLine{{Name: "sock", Quantity: 2}},
+			},
+			exp: ErrValidation{},
+		},
+		"nested errors should be prefixed": {
+			val: testOrder{
+				Reference: "abc",
+				Address:   testAddress{Line1: "1 The Street", PostCode: "oops"},
+				Billing:   &testAddress{PostCode: "NW1A 1AA"},
+				Lines:     []testLine{{Name: "sock", Quantity: 2}},
+				Skipped:   testAddress{},
+			},
+			exp: ErrValidation{
+				"address.postcode": {fmt.Sprintf(validateUkPostCode, "oops")},
+				"billing.line1":    {validateEmpty},
+			},
+		},
+		"slice errors should be indexed": {
+			val: testOrder{
+				Reference: "abc",
+				Address:   validAddress,
+				Lines: []testLine{
+					{Name: "sock", Quantity: 2},
+					{Quantity: 1},
+					{Name: "shoe", Quantity: 0},
+				},
+			},
+			exp: ErrValidation{
+				"lines[1].name":     {validateEmpty},
+				"lines[2].quantity": {fmt.Sprintf(validatePositive, 0)},
+			},
+		},
+		"tag and nested errors should both be reported": {
+			val: testOrder{},
+			exp: ErrValidation{
+				"reference":        {validateEmpty},
+				"lines":            {fmt.Sprintf(validateMinItems, 1)},
+				"address.line1":    {validateEmpty},
+				"address.postcode": {fmt.Sprintf(validateUkPostCode, "")},
+			},
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.exp, ValidateStruct(test.val))
+		})
+	}
+}
@@ -13,9 +13,14 @@
 package validator
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
+	"runtime"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 )
 
 // Validator is an interface that can be implemented on a struct
@@ -29,10 +34,10 @@ import (
 //    ShouldBeTrue bool
 //  }
 //
-//  func(m *MyStruct) Validate() error{
+//  func (m MyStruct) Validate() validator.ErrValidation {
 //      return validator.New().
-//          Validate("myProp", validator.Length(m.MyProp,10,20)).
-//          Validate("shouldBeTrue", validator.Bool(m.ShouldBeTrue, true))
+//          Validate("myProp", validator.StrLength(m.MyProp, 10, 20)).
+//          Validate("shouldBeTrue", validator.Equal(m.ShouldBeTrue, true))
 //  }
 //
 type Validator interface {
@@ -63,6 +68,13 @@ func (v ValidationFunc) String() string {
 // found against each. This can then be converted for output to a user.
 type ErrValidation map[string][]string
 
+// FieldMessages contains the messages recorded against a single field,
+// it is returned by ErrValidation.ToSlice.
+type FieldMessages struct {
+	Field    string   `json:"field"`
+	Messages []string `json:"messages"`
+}
+
 // New will create and return a new ErrValidation which can have Validate functions chained.
 func New() ErrValidation {
 	return map[string][]string{}
@@ -71,7 +83,7 @@ func New() ErrValidation {
 // Validate will log any errors found when evaluating the list of validation functions
 // supplied to it.
 func (e ErrValidation) Validate(field string, fns ...ValidationFunc) ErrValidation {
-	out := make([]string, 0)
+	out := make([]string, 0, len(fns))
 	for _, fn := range fns {
 		if err := fn(); err != nil {
 			out = append(out, err.Error())
@@ -83,6 +95,179 @@ func (e ErrValidation) Validate(field string, fns ...ValidationFunc) ErrValidati
 	return e
 }
 
+// ValidateIndexed will log any errors found when evaluating the list of validation
+// functions against an item in a slice, keyed as field[index], ie "tags[3]".
+// This allows clients to highlight the specific row that failed:
+//   for i, tag := range req.Tags {
+//       err = err.ValidateIndexed("tags", i, validator.StrLength(tag, 1, 20))
+//   }
+func (e ErrValidation) ValidateIndexed(field string, index int, fns ...ValidationFunc) ErrValidation {
+	return e.Validate(IndexedField(field, index), fns...)
+}
+
+// IndexedField will return the key used for an item in a slice, field[index].
+// It can be combined with ValidateNested or a suffix to key the fields of an
+// item, ie IndexedField("items", 3)+".price" returns "items[3].price".
+func IndexedField(field string, index int) string {
+	return field + "[" + strconv.Itoa(index) + "]"
+}
+
+// Add will append a message to the errors recorded against field. This can be
+// used for failures found outside of a ValidationFunc, such as a database lookup,
+// and can be chained with Validate:
+//   err := validator.New().
+//       Validate("name", validator.StrLength(name, 1, 20)).
+//       Add("email", "email address is already registered").
+//       Err()
+func (e ErrValidation) Add(field, msg string) ErrValidation {
+	e[field] = append(e[field], msg)
+	return e
+}
+
+// ValidateFirst will evaluate the list of validation functions supplied to it
+// in order and stop at the first failure, logging only that error.
+//
+// This is useful where later functions are expensive or meaningless if an
+// earlier one fails.
+func (e ErrValidation) ValidateFirst(field string, fns ...ValidationFunc) ErrValidation {
+	for _, fn := range fns {
+		if err := fn(); err != nil {
+			e[field] = []string{err.Error()}
+			break
+		}
+	}
+	return e
+}
+
+// ValidateParallel will evaluate the list of validation functions concurrently
+// using a pool of at most GOMAXPROCS workers, logging any errors found.
+//
+// Errors are recorded in the same order as the functions were supplied so output
+// matches Validate. This is useful where functions are slow, for example those
+// calling external services.
+//
+// If a function panics the panic is recovered in the worker and raised again
+// on the calling goroutine once all functions have returned, so it can be
+// handled by the caller as it would be with Validate.
+func (e ErrValidation) ValidateParallel(field string, fns ...ValidationFunc) ErrValidation {
+	if len(fns) <= 1 {
+		return e.Validate(field, fns...)
+	}
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(fns) {
+		workers = len(fns)
+	}
+	results := make([]error, len(fns))
+	panics := make([]interface{}, len(fns))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				panics[i], results[i] = safeCall(fns[i])
+			}
+		}()
+	}
+	for i := range fns {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	for _, p := range panics {
+		if p != nil {
+			panic(p)
+		}
+	}
+	out := make([]string, 0, len(fns))
+	for _, err := range results {
+		if err != nil {
+			out = append(out, err.Error())
+		}
+	}
+	if len(out) > 0 {
+		e[field] = out
+	}
+	return e
+}
+
+// safeCall will run fn, returning any value it panics with rather than
+// letting the panic escape the goroutine.
+func safeCall(fn ValidationFunc) (p interface{}, err error) {
+	defer func() {
+		p = recover()
+	}()
+	return nil, fn()
+}
+
+// ValidateNested will evaluate a child Validator, v, and merge any errors found
+// keyed as prefix.field, ie "address.postcode". This allows nested structs to
+// be validated with their own Validate method.
+// A nil v, including a typed nil pointer such as an unset optional *Address,
+// is skipped and adds no errors.
+func (e ErrValidation) ValidateNested(prefix string, v Validator) ErrValidation {
+	if v == nil || isNilValue(reflect.ValueOf(v)) {
+		return e
+	}
+	nested := New()
+	for k, vv := range v.Validate() {
+		nested[prefix+"."+k] = vv
+	}
+	return e.Merge(nested)
+}
+
+// Merge will add all errors found in other to this ErrValidation.
+// Where a field exists in both, the messages from other are appended.
+func (e ErrValidation) Merge(other ErrValidation) ErrValidation {
+	for k, vv := range other {
+		e[k] = append(e[k], vv...)
+	}
+	return e
+}
+
+// Fields will return the names of all fields with errors in sorted order.
+func (e ErrValidation) Fields() []string {
+	fields := make([]string, 0, len(e))
+	for k := range e {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// ToSlice will return the errors found as a slice sorted by field name. This gives
+// a stable order for APIs or frameworks that expect a list rather than a map.
+func (e ErrValidation) ToSlice() []FieldMessages {
+	out := make([]FieldMessages, 0, len(e))
+	for _, f := range e.Fields() {
+		out = append(out, FieldMessages{Field: f, Messages: e[f]})
+	}
+	return out
+}
+
+// FirstErrors will return each field mapped to only the first message recorded
+// against it, for UIs that show a single message per field.
+func (e ErrValidation) FirstErrors() map[string]string {
+	out := make(map[string]string, len(e))
+	for k, vv := range e {
+		if len(vv) > 0 {
+			out[k] = vv[0]
+		}
+	}
+	return out
+}
+
+// Has will return true if at least one error has been recorded against field.
+func (e ErrValidation) Has(field string) bool {
+	return len(e[field]) > 0
+}
+
+// Get will return the errors recorded against field, or nil if there are none.
+func (e ErrValidation) Get(field string) []string {
+	return e[field]
+}
+
 // Err will return nil if no errors are found, ie all validators return valid
 // or ErrValidation if an error has been found.
 func (e ErrValidation) Err() error {
@@ -107,12 +292,63 @@ func (e ErrValidation) String() string {
 	return strings.Join(errs, ", ")
 }
 
+// PrettyString will return a multi-line representation of any errors found,
+// with fields sorted and each message on its own bulleted line, aligned
+// under the longest field name. This is easier to read in terminal output
+// than String:
+//   amount  - value 0 is smaller than minimum 10
+//   name    - value must be between 1 and 20 characters
+//           - value must not contain whitespace
+func (e ErrValidation) PrettyString() string {
+	if len(e) == 0 {
+		return "no validation errors"
+	}
+	fields := e.Fields()
+	width := 0
+	for _, f := range fields {
+		if len(f) > width {
+			width = len(f)
+		}
+	}
+	lines := make([]string, 0, len(fields))
+	for _, f := range fields {
+		name := f
+		for _, msg := range e[f] {
+			lines = append(lines, fmt.Sprintf("%-*s  - %s", width, name, msg))
+			name = ""
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 // Error implements the Error interface and ensure that ErrValidation
 // can be passed as an error as well and being printable.
 func (e ErrValidation) Error() string {
 	return e.String()
 }
 
+// ErrValidationResponse is a canonical response body for an ErrValidation,
+// it encodes the errors wrapped in an errors object with the fields in sorted
+// order and can be decoded back into an ErrValidationResponse:
+//  {
+//      "errors": {
+//          "count": ["value 0 should be greater than 0"],
+//          "isEnabled": ["value true does not evaluate to false"]
+//      }
+//  }
+type ErrValidationResponse struct {
+	Errors ErrValidation `json:"errors"`
+}
+
+// Response will return e wrapped in an ErrValidationResponse, ready to be
+// encoded as a response body. A nil e is returned as an empty errors object.
+func (e ErrValidation) Response() ErrValidationResponse {
+	if e == nil {
+		e = ErrValidation{}
+	}
+	return ErrValidationResponse{Errors: e}
+}
+
 // BadRequest implements the err BadRequest behaviour
 // from the https://github.com/theflyingcodr/lathos package.
 func (e ErrValidation) BadRequest() bool {
@@ -149,3 +385,18 @@ func NewFromError(fieldName string, err error) error {
 		fieldName: []string{err.Error()},
 	}.Err()
 }
+
+// HasFieldError will unwrap err and return true if it contains an ErrValidation
+// with at least one error recorded against field.
+//
+// This is useful in tests or middleware to check a specific field failed:
+//   if validator.HasFieldError(err, "name") {
+//	    // handle the name failure
+//   }
+func HasFieldError(err error, field string) bool {
+	var e ErrValidation
+	if !errors.As(err, &e) {
+		return false
+	}
+	return e.Has(field)
+}
@@ -1,13 +1,33 @@
 package validator
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/matryer/is"
 )
 
+// ensure the test validators and ErrValidation satisfy the expected interfaces.
+var (
+	_ Validator = testAddress{}
+	_ Validator = (*testLine)(nil)
+	_ error     = ErrValidation{}
+)
+
+type testAddress struct {
+	Line1    string
+	PostCode string
+}
+
+func (a testAddress) Validate() ErrValidation {
+	return New().
+		Validate("line1", NotEmpty(a.Line1)).
+		Validate("postcode", UKPostCode(a.PostCode))
+}
+
 func Test_NewSingleError(t *testing.T) {
 	t.Parallel()
 	is := is.New(t)
@@ -75,3 +95,511 @@ func Test_NewFromError(t *testing.T) {
 		})
 	}
 }
+
+func Test_HasFieldError(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tests := map[string]struct {
+		err   error
+		field string
+		exp   bool
+	}{
+		"wrapped validation error with field should return true": {
+			err:   fmt.Errorf("failed to create: %w", NewSingleError("name", []string{"too short"})),
+			field: "name",
+			exp:   true,
+		}, "validation error without field should return false": {
+			err:   NewSingleError("name", []string{"too short"}),
+			field: "dob",
+			exp:   false,
+		}, "field with no messages should return false": {
+			err:   ErrValidation{"name": {}},
+			field: "name",
+			exp:   false,
+		}, "plain error should return false": {
+			err:   errors.New("I failed"),
+			field: "name",
+			exp:   false,
+		}, "nil error should return false": {
+			err:   nil,
+			field: "name",
+			exp:   false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.exp, HasFieldError(test.err, test.field))
+		})
+	}
+}
+
+func Test_Validate(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tests := map[string]struct {
+		fns []ValidationFunc
+		exp ErrValidation
+	}{
+		"passing validators should record nothing": {
+			fns: []ValidationFunc{Equal(1, 1), PositiveNumber(1)},
+			exp: ErrValidation{},
+		}, "single failing validator should record exactly one message": {
+			fns: []ValidationFunc{Equal(1, 1), PositiveNumber(-1), Equal(2, 2)},
+			exp: ErrValidation{
+				"test": {"value -1 should be greater than 0"},
+			},
+		}, "multiple failing validators should record each message in order": {
+			fns: []ValidationFunc{PositiveNumber(-1), Equal(1, 2)},
+			exp: ErrValidation{
+				"test": {"value -1 should be greater than 0", "value 1 does not evaluate to 2"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.exp, New().Validate("test", test.fns...))
+		})
+	}
+}
+
+func Test_ValidateFirst(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tests := map[string]struct {
+		fns    []ValidationFunc
+		exp    ErrValidation
+		called bool
+	}{
+		"passing validators should record nothing": {
+			fns:    []ValidationFunc{Equal(1, 1), PositiveNumber(1)},
+			exp:    ErrValidation{},
+			called: true,
+		}, "only first failure should be recorded": {
+			fns: []ValidationFunc{Equal(1, 1), PositiveNumber(-1), Equal(1, 2)},
+			exp: ErrValidation{
+				"test": {"value -1 should be greater than 0"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			called := false
+			fns := append(test.fns, func() error {
+				called = true
+				return nil
+			})
+			is.Equal(test.exp, New().ValidateFirst("test", fns...))
+			is.Equal(test.called, called)
+		})
+	}
+}
+
+func Test_Response(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tests := map[string]struct {
+		err ErrValidation
+		exp string
+	}{
+		"nil validator should return empty errors object": {
+			err: nil,
+			exp: `{"errors":{}}`,
+		}, "empty validator should return empty errors object": {
+			err: New(),
+			exp: `{"errors":{}}`,
+		}, "fields should be wrapped and sorted": {
+			err: ErrValidation{
+				"name":  {"too short", "too boring"},
+				"count": {"value 0 should be greater than 0"},
+				"dob":   {"invalid"},
+			},
+			exp: `{"errors":{"count":["value 0 should be greater than 0"],"dob":["invalid"],"name":["too short","too boring"]}}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			bb, err := json.Marshal(test.err.Response())
+			is.NoErr(err)
+			is.Equal(test.exp, string(bb))
+			var out ErrValidationResponse
+			is.NoErr(json.Unmarshal(bb, &out))
+			is.Equal(test.err.Response(), out)
+		})
+	}
+}
+
+func Test_JSONRoundTrip(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	err := ErrValidation{"name": {"bad"}}
+	bb, jsonErr := json.Marshal(err)
+	is.NoErr(jsonErr)
+	// ErrValidation keeps the default map encoding.
+	is.Equal(`{"name":["bad"]}`, string(bb))
+	var out ErrValidation
+	is.NoErr(json.Unmarshal(bb, &out))
+	is.Equal(err, out)
+}
+
+func Test_Merge(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tests := map[string]struct {
+		err   ErrValidation
+		other ErrValidation
+		exp   ErrValidation
+	}{
+		"merging nil should not change errors": {
+			err:   ErrValidation{"name": {"too short"}},
+			other: nil,
+			exp:   ErrValidation{"name": {"too short"}},
+		}, "distinct fields should coexist": {
+			err:   ErrValidation{"name": {"too short"}},
+			other: ErrValidation{"dob": {"too young"}},
+			exp: ErrValidation{
+				"name": {"too short"},
+				"dob":  {"too young"},
+			},
+		}, "overlapping fields should accumulate messages": {
+			err: ErrValidation{"name": {"too short"}},
+			other: ErrValidation{
+				"name": {"too boring", "too common"},
+				"dob":  {"too young"},
+			},
+			exp: ErrValidation{
+				"name": {"too short", "too boring", "too common"},
+				"dob":  {"too young"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.exp, test.err.Merge(test.other))
+		})
+	}
+}
+
+func Test_ValidateNested(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tests := map[string]struct {
+		err ErrValidation
+		v   Validator
+		exp ErrValidation
+	}{
+		"valid child should not add errors": {
+			err: New(),
+			v:   testAddress{Line1: "1 The Street", PostCode: "NW1A 1AA"},
+			exp: ErrValidation{},
+		}, "nil child should not add errors": {
+			err: New(),
+			v:   nil,
+			exp: ErrValidation{},
+		}, "typed nil pointer child should not add errors": {
+			err: New(),
+			v:   (*testLine)(nil),
+			exp: ErrValidation{},
+		}, "child errors should be prefixed": {
+			err: New(),
+			v:   testAddress{PostCode: "NW1A 1AA"},
+			exp: ErrValidation{
+				"billing.line1": {validateEmpty},
+			},
+		}, "child errors should merge into parent": {
+			err: ErrValidation{
+				"name":             {"too short"},
+				"billing.postcode": {"required"},
+			},
+			v: testAddress{PostCode: "oops"},
+			exp: ErrValidation{
+				"name":             {"too short"},
+				"billing.line1":    {validateEmpty},
+				"billing.postcode": {"required", fmt.Sprintf(validateUkPostCode, "oops")},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.exp, test.err.ValidateNested("billing", test.v))
+		})
+	}
+}
+
+func Test_ValidateIndexed(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tags := []string{"go", "", "validation", ""}
+	err := New()
+	for i, tag := range tags {
+		err = err.ValidateIndexed("tags", i, NotEmpty(tag))
+	}
+	is.Equal(ErrValidation{
+		"tags[1]": {validateEmpty},
+		"tags[3]": {validateEmpty},
+	}, err)
+}
+
+func Test_IndexedField(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	is.Equal("items[3]", IndexedField("items", 3))
+	is.Equal("items[3].price", IndexedField("items", 3)+".price")
+
+	addresses := []testAddress{
+		{Line1: "1 The Street", PostCode: "NW1A 1AA"},
+		{PostCode: "oops"},
+	}
+	err := ErrValidation{
+		"items[1].price": {"price required"},
+	}
+	err = err.ValidateIndexed("items", 1, NotEmpty(""))
+	for i, a := range addresses {
+		err = err.ValidateNested(IndexedField("addresses", i), a)
+	}
+	err = err.Merge(ErrValidation{"items[1].price": {"price too high"}})
+	is.Equal(ErrValidation{
+		"items[1]":              {validateEmpty},
+		"items[1].price":        {"price required", "price too high"},
+		"addresses[1].line1":    {validateEmpty},
+		"addresses[1].postcode": {fmt.Sprintf(validateUkPostCode, "oops")},
+	}, err)
+}
+
+func Test_ValidateParallel(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	slow := func(fn ValidationFunc) ValidationFunc {
+		return func() error {
+			time.Sleep(10 * time.Millisecond)
+			return fn()
+		}
+	}
+	tests := map[string]struct {
+		fns []ValidationFunc
+		exp ErrValidation
+	}{
+		"no validators should record nothing": {
+			exp: ErrValidation{},
+		}, "passing validators should record nothing": {
+			fns: []ValidationFunc{slow(Equal(1, 1)), slow(PositiveNumber(1))},
+			exp: ErrValidation{},
+		}, "failing validators should be recorded in order": {
+			fns: []ValidationFunc{
+				slow(PositiveNumber(-1)),
+				slow(Equal(1, 1)),
+				slow(Equal(1, 2)),
+				slow(PositiveNumber(-2)),
+				slow(PositiveNumber(-3)),
+				slow(Equal(2, 2)),
+				slow(PositiveNumber(-4)),
+			},
+			exp: ErrValidation{
+				"test": {
+					"value -1 should be greater than 0",
+					"value 1 does not evaluate to 2",
+					"value -2 should be greater than 0",
+					"value -3 should be greater than 0",
+					"value -4 should be greater than 0",
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.exp, New().ValidateParallel("test", test.fns...))
+			is.Equal(New().Validate("test", test.fns...), New().ValidateParallel("test", test.fns...))
+		})
+	}
+}
+
+func Test_ValidateParallelPanic(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	boom := func() error {
+		panic("boom")
+	}
+	for name, fns := range map[string][]ValidationFunc{
+		"single function":    {boom},
+		"multiple functions": {Equal(1, 1), boom, PositiveNumber(-1)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			defer func() {
+				is.Equal("boom", recover())
+			}()
+			New().ValidateParallel("test", fns...)
+		})
+	}
+}
+
+func Test_Accessors(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tests := map[string]struct {
+		err       ErrValidation
+		expFields []string
+		expHas    bool
+		expGet    []string
+	}{
+		"empty validator should have no fields": {
+			err:       New(),
+			expFields: []string{},
+		}, "populated validator should return sorted fields": {
+			err: ErrValidation{
+				"name":  {"too short", "too boring"},
+				"count": {"too small"},
+				"dob":   {"invalid"},
+			},
+			expFields: []string{"count", "dob", "name"},
+			expHas:    true,
+			expGet:    []string{"too short", "too boring"},
+		}, "populated validator without field should not have it": {
+			err: ErrValidation{
+				"count": {"too small"},
+			},
+			expFields: []string{"count"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expFields, test.err.Fields())
+			is.Equal(test.expHas, test.err.Has("name"))
+			is.Equal(test.expGet, test.err.Get("name"))
+		})
+	}
+}
+
+func Test_PrettyString(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tests := map[string]struct {
+		err ErrValidation
+		exp string
+	}{
+		"no errors": {
+			err: New(),
+			exp: "no validation errors",
+		},
+		"single field": {
+			err: ErrValidation{"name": {"too short"}},
+			exp: "name  - too short",
+		},
+		"multiple fields should be sorted and aligned": {
+			err: ErrValidation{
+				"name":   {"too short", "contains whitespace"},
+				"amount": {"too small"},
+				"id":     {"required"},
+			},
+			exp: "amount  - too small\n" +
+				"id      - required\n" +
+				"name    - too short\n" +
+				"        - contains whitespace",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.exp, test.err.PrettyString())
+		})
+	}
+}
+
+func Test_ToSlice(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	err := ErrValidation{
+		"name":    {"too short", "contains whitespace"},
+		"amount":  {"too small"},
+		"address": {"required"},
+	}
+	exp := []FieldMessages{
+		{Field: "address", Messages: []string{"required"}},
+		{Field: "amount", Messages: []string{"too small"}},
+		{Field: "name", Messages: []string{"too short", "contains whitespace"}},
+	}
+	// repeat to catch any reliance on map iteration order.
+	for i := 0; i < 10; i++ {
+		is.Equal(exp, err.ToSlice())
+	}
+	is.Equal([]FieldMessages{}, New().ToSlice())
+	b, jsonErr := json.Marshal(err.ToSlice()[:1])
+	is.NoErr(jsonErr)
+	is.Equal(`[{"field":"address","messages":["required"]}]`, string(b))
+}
+
+func Test_FirstErrors(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	err := New().
+		Validate("name", StrLength("", 4, 10), NotEmpty("")).
+		Validate("postcode", UKPostCode("oops")).
+		Validate("email", Email("test@test.com"))
+	is.Equal(map[string]string{
+		"name":     fmt.Sprintf(validateLength, 4, 10),
+		"postcode": fmt.Sprintf(validateUkPostCode, "oops"),
+	}, err.FirstErrors())
+	is.Equal(2, len(err["name"]))
+	is.Equal(map[string]string{}, New().FirstErrors())
+}
+
+func Test_Add(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tests := map[string]struct {
+		err ErrValidation
+		exp ErrValidation
+	}{
+		"adding to a new field should create it": {
+			err: New(),
+			exp: ErrValidation{
+				"email": {"already registered"},
+			},
+		}, "adding to an existing field should append": {
+			err: ErrValidation{
+				"email": {"invalid email"},
+				"name":  {"too short"},
+			},
+			exp: ErrValidation{
+				"email": {"invalid email", "already registered"},
+				"name":  {"too short"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.exp, test.err.Add("email", "already registered"))
+		})
+	}
+}
+
+func Test_AddChained(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	err := New().
+		Validate("name", StrLength("", 1, 20)).
+		Add("name", "name is reserved").
+		Add("email", "already registered").
+		Err()
+	is.Equal(ErrValidation{
+		"name":  {fmt.Sprintf(validateLength, 1, 20), "name is reserved"},
+		"email": {"already registered"},
+	}, err)
+}
@@ -0,0 +1,92 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// vatFormat is the prefix used on VAT numbers for a country, which is
+// usually but not always its ISO code, and the pattern the rest must match.
+type vatFormat struct {
+	prefix  string
+	pattern *regexp.Regexp
+}
+
+var (
+	reVATAT = regexp.MustCompile(`^U\d{8}$`)
+	reVATBE = regexp.MustCompile(`^[01]\d{9}$`)
+	reVATDE = regexp.MustCompile(`^\d{9}$`)
+	reVATDK = regexp.MustCompile(`^\d{8}$`)
+	reVATES = regexp.MustCompile(`^[A-Z0-9]\d{7}[A-Z0-9]$`)
+	reVATFI = regexp.MustCompile(`^\d{8}$`)
+	reVATFR = regexp.MustCompile(`^[A-HJ-NP-Z0-9]{2}\d{9}$`)
+	reVATGB = regexp.MustCompile(`^(?:\d{9}|\d{12}|GD[0-4]\d{2}|HA[5-9]\d{2})$`)
+	reVATGR = regexp.MustCompile(`^\d{9}$`)
+	reVATIE = regexp.MustCompile(`^(?:\d{7}[A-W][A-I]?|\d[A-Z+*]\d{5}[A-W])$`)
+	reVATIT = regexp.MustCompile(`^\d{11}$`)
+	reVATNL = regexp.MustCompile(`^\d{9}B\d{2}$`)
+	reVATPL = regexp.MustCompile(`^\d{10}$`)
+	reVATPT = regexp.MustCompile(`^\d{9}$`)
+	reVATSE = regexp.MustCompile(`^\d{10}01$`)
+)
+
+// vatNumberFormat returns the format of VAT numbers issued by the upper case
+// ISO 3166-1 alpha-2 country code, countryCode, or false if the country is
+// not supported.
+func vatNumberFormat(countryCode string) (vatFormat, bool) {
+	switch countryCode {
+	case "AT":
+		return vatFormat{prefix: "AT", pattern: reVATAT}, true
+	case "BE":
+		return vatFormat{prefix: "BE", pattern: reVATBE}, true
+	case "DE":
+		return vatFormat{prefix: "DE", pattern: reVATDE}, true
+	case "DK":
+		return vatFormat{prefix: "DK", pattern: reVATDK}, true
+	case "ES":
+		return vatFormat{prefix: "ES", pattern: reVATES}, true
+	case "FI":
+		return vatFormat{prefix: "FI", pattern: reVATFI}, true
+	case "FR":
+		return vatFormat{prefix: "FR", pattern: reVATFR}, true
+	case "GB":
+		return vatFormat{prefix: "GB", pattern: reVATGB}, true
+	case "GR":
+		return vatFormat{prefix: "EL", pattern: reVATGR}, true
+	case "IE":
+		return vatFormat{prefix: "IE", pattern: reVATIE}, true
+	case "IT":
+		return vatFormat{prefix: "IT", pattern: reVATIT}, true
+	case "NL":
+		return vatFormat{prefix: "NL", pattern: reVATNL}, true
+	case "PL":
+		return vatFormat{prefix: "PL", pattern: reVATPL}, true
+	case "PT":
+		return vatFormat{prefix: "PT", pattern: reVATPT}, true
+	case "SE":
+		return vatFormat{prefix: "SE", pattern: reVATSE}, true
+	}
+	return vatFormat{}, false
+}
+
+// VATNumber will validate that a string, val, matches the VAT number format
+// for the country identified by its ISO 3166-1 alpha-2 code, countryCode.
+// The VAT prefix, such as "DE" or "EL" for Greece, is optional and spaces
+// and case are ignored, so "de 123456789" is valid for "DE".
+// Supported countries are AT, BE, DE, DK, ES, FI, FR, GB, GR, IE, IT, NL,
+// PL, PT and SE, any other country will return an error rather than pass.
+// It only checks the format, not that the number is registered or its check digits.
+func VATNumber(val, countryCode string) ValidationFunc {
+	return func() error {
+		f, ok := vatNumberFormat(strings.ToUpper(countryCode))
+		if !ok {
+			return fmt.Errorf(message(MessageVATCountry), countryCode)
+		}
+		num := strings.TrimPrefix(strings.ToUpper(strings.ReplaceAll(val, " ", "")), f.prefix)
+		if f.pattern.MatchString(num) {
+			return nil
+		}
+		return fmt.Errorf(message(MessageVATNumber), val, countryCode)
+	}
+}
@@ -0,0 +1,91 @@
+package validator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestVATNumber(t *testing.T) {
+	t.Parallel()
+	is := is.New(t)
+	tt := map[string]struct {
+		val     string
+		country string
+		expErr  error
+	}{
+		"GB number with prefix should pass": {
+			val:     "GB123456789",
+			country: "GB",
+		},
+		"GB number with spaces and no prefix should pass": {
+			val:     "123 4567 89",
+			country: "GB",
+		},
+		"GB government department should pass": {
+			val:     "GBGD001",
+			country: "GB",
+		},
+		"GB number with wrong length should fail": {
+			val:     "GB1234567890",
+			country: "GB",
+			expErr:  fmt.Errorf(validateVATNumber, "GB1234567890", "GB"),
+		},
+		"DE number should pass": {
+			val:     "de123456789",
+			country: "de",
+		},
+		"DE number with letters should fail": {
+			val:     "DE12345678X",
+			country: "DE",
+			expErr:  fmt.Errorf(validateVATNumber, "DE12345678X", "DE"),
+		},
+		"FR number with letter key should pass": {
+			val:     "FRXX123456789",
+			country: "FR",
+		},
+		"FR number using I in key should fail": {
+			val:     "FRIX123456789",
+			country: "FR",
+			expErr:  fmt.Errorf(validateVATNumber, "FRIX123456789", "FR"),
+		},
+		"IE old style number should pass": {
+			val:     "IE1A23456B",
+			country: "IE",
+		},
+		"IE new style number should pass": {
+			val:     "IE1234567WA",
+			country: "IE",
+		},
+		"NL number should pass": {
+			val:     "NL123456789B01",
+			country: "NL",
+		},
+		"NL number missing B should fail": {
+			val:     "NL123456789001",
+			country: "NL",
+			expErr:  fmt.Errorf(validateVATNumber, "NL123456789001", "NL"),
+		},
+		"GR number with EL prefix should pass": {
+			val:     "EL123456789",
+			country: "GR",
+		},
+		"another country prefix should fail": {
+			val:     "FR123456789",
+			country: "DE",
+			expErr:  fmt.Errorf(validateVATNumber, "FR123456789", "DE"),
+		},
+		"unsupported country should fail": {
+			val:     "US123456789",
+			country: "US",
+			expErr:  fmt.Errorf(validateVATCountry, "US"),
+		},
+	}
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			is = is.NewRelaxed(t)
+			is.Equal(test.expErr, VATNumber(test.val, test.country)())
+		})
+	}
+}